@@ -2,39 +2,101 @@ package main
 
 import (
 	"context"
+	"log"
+	"os"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
 
 	"github.com/romaopatrick/assets-balancer/internal/adapters"
 	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
 
 	"github.com/gin-gonic/gin"
 	"github.com/spf13/viper"
 	"go.mongodb.org/mongo-driver/mongo"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 	"go.uber.org/dig"
+	"golang.org/x/exp/slog"
 )
 
 func main() {
 	c := provideDependencies()
 	if err := c.Invoke(startupApplication); err != nil {
-		panic(err)
+		log.Fatal(err)
 	}
 }
 
+// startupApplication serves until SIGINT or SIGTERM, then drains the
+// servers, stops the jobs and disconnects from Mongo.
 func startupApplication(
+	logger *slog.Logger,
 	eng *gin.Engine,
+	hs *adapters.HttpServer,
 	cl *mongo.Client,
-	ph *adapters.AssetsBalancerHandler) {
-	defer cl.Disconnect(context.Background())
-	adapters.ConfigureRouter(eng, ph)
-	eng.Run(":8081")
+	ph *adapters.AssetsBalancerHandler,
+	sh *adapters.ContributionScheduleHandler,
+	nh *adapters.NotificationHandler,
+	ah *adapters.AuditHandler,
+	v2h *adapters.AssetsBalancerV2Handler,
+	gh *adapters.GraphQlHandler,
+	gsh *adapters.GroupStreamHandler,
+	hh *adapters.HealthHandler,
+	mh *adapters.MetricsHandler,
+	ih *adapters.IdempotencyHandler,
+	th *adapters.PortfolioTemplateHandler,
+	gs *adapters.GrpcServer,
+	tp *sdktrace.TracerProvider,
+	rj *adapters.AssetsRevaluationJob,
+	cs *adapters.ContributionScheduler,
+	ej *adapters.EventDispatcherJob,
+	tj *adapters.TrashPurgeJob,
+	np *adapters.NotificationDeliveryPool) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	var wg sync.WaitGroup
+	for _, start := range []func(context.Context){rj.Start, cs.Start, ej.Start, tj.Start, gs.Start, np.Start} {
+		wg.Add(1)
+		go func(start func(context.Context)) {
+			defer wg.Done()
+			start(ctx)
+		}(start)
+	}
+	adapters.ConfigureRouter(eng, ph, sh, nh, ah, v2h, gh, gsh, hh, mh, ih, th)
+	hs.OnDrain(hh.Drain)
+	hs.OnShutdown(gsh.Close)
+	err := hs.Start(ctx)
+	stop()
+	wg.Wait()
+
+	dctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if derr := cl.Disconnect(dctx); derr != nil {
+		logger.Error("failed to disconnect from mongo", "error", derr)
+	}
+	if terr := tp.Shutdown(dctx); terr != nil {
+		logger.Error("failed to flush traces", "error", terr)
+	}
+	return err
 }
 
 func provideDependencies() (c *dig.Container) {
 	c = dig.New()
 	c.Provide(initializeViper)
-	c.Provide(gin.Default)
+	c.Provide(adapters.NewLogger)
+	c.Provide(adapters.NewEngine)
+	c.Provide(adapters.NewHttpServer)
+	c.Provide(adapters.NewTracerProvider)
 	provideMongo(c)
 	provideRepositories(c)
+	providePriceProviders(c)
+	provideEventSinks(c)
+	provideJobs(c)
 	provideHandlers(c)
+	provideGrpc(c)
 	provideUseCases(c)
 
 	return
@@ -44,10 +106,18 @@ func provideMongo(c *dig.Container) {
 	c.Provide(adapters.NewClientOptions)
 	c.Provide(adapters.NewMongoClient)
 	c.Provide(adapters.NewMongoDatabase)
+	c.Provide(adapters.NewMongoTransactor)
+	c.Provide(adapters.NewHealthChecks)
 }
 
+// initializeViper reads config.json. Any setting can be overridden by an
+// environment variable named after its key, e.g. ASSETS_BALANCER_SERVER_ADDRESS
+// for "server.address".
 func initializeViper() *viper.Viper {
 	v := viper.New()
+	v.SetEnvPrefix("assets_balancer")
+	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
+	v.AutomaticEnv()
 	v.AddConfigPath("./")
 	v.AddConfigPath("/app/assets-balancer/cmd")
 	v.SetConfigFile("config.json")
@@ -59,10 +129,57 @@ func initializeViper() *viper.Viper {
 
 func provideRepositories(c *dig.Container) {
 	c.Provide(adapters.NewMongoDbRepository[*domain.AssetsGroup])
+	c.Provide(adapters.NewMongoDbRepository[*domain.RebalancePlan])
+	c.Provide(adapters.NewMongoDbRepository[*domain.NotificationSubscription])
+	c.Provide(adapters.NewMongoDbRepository[*domain.NotificationDelivery])
+	c.Provide(adapters.NewMongoDbRepository[*domain.DomainEvent])
+	c.Provide(adapters.NewMongoDbRepository[*domain.AuditEntry])
+	c.Provide(adapters.NewMongoDbRepository[*domain.PortfolioTemplate])
+	c.Provide(adapters.NewIdempotencyRepository)
+	c.Provide(adapters.NewChangeJournal)
+}
+func providePriceProviders(c *dig.Container) {
+	c.Provide(adapters.NewPriceProvider)
+}
+func provideEventSinks(c *dig.Container) {
+	c.Provide(adapters.NewInProcessEventBus)
+	c.Provide(adapters.NewEventSinks)
+	c.Provide(adapters.NewInProcessGroupUpdateBroker)
+}
+func provideJobs(c *dig.Container) {
+	c.Provide(adapters.NewAssetsRevaluationJob)
+	c.Provide(adapters.NewContributionScheduler)
+	c.Provide(adapters.NewEventDispatcherJob)
+	c.Provide(adapters.NewTrashPurgeJob)
+	c.Provide(adapters.NewNotificationDeliveryPool)
 }
 func provideHandlers(c *dig.Container) {
 	c.Provide(adapters.NewAssetsBalancerHandler)
+	c.Provide(adapters.NewContributionScheduleHandler)
+	c.Provide(adapters.NewNotificationHandler)
+	c.Provide(adapters.NewAuditHandler)
+	c.Provide(adapters.NewAssetsBalancerV2Handler)
+	c.Provide(adapters.NewGraphQlHandler)
+	c.Provide(adapters.NewGroupStreamHandler)
+	c.Provide(adapters.NewHealthHandler)
+	c.Provide(adapters.NewMetricsHandler)
+	c.Provide(adapters.NewIdempotencyHandler)
+	c.Provide(adapters.NewPortfolioTemplateHandler)
+}
+func provideGrpc(c *dig.Container) {
+	c.Provide(adapters.NewAssetsBalancerGrpcServer)
+	c.Provide(adapters.NewPortfolioTemplateGrpcServer)
+	c.Provide(adapters.NewGrpcServer)
 }
 func provideUseCases(c *dig.Container) {
 	c.Provide(adapters.NewAssetsBalancerUseCase)
+	c.Provide(adapters.NewAssetsRevaluationUseCase)
+	c.Provide(adapters.NewContributionScheduleUseCase)
+	c.Provide(adapters.NewNotificationService, dig.As(
+		new(ports.NotificationPublisher),
+		new(ports.NotificationUseCase)))
+	c.Provide(adapters.NewEventDispatcherUseCase)
+	c.Provide(adapters.NewAuditUseCase)
+	c.Provide(adapters.NewTrashPurgeUseCase)
+	c.Provide(adapters.NewPortfolioTemplateUseCase)
 }
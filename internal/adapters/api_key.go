@@ -0,0 +1,68 @@
+package adapters
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+)
+
+const apiKeyHeader = "X-API-Key"
+
+type (
+	// apiKeys tells the principal each API key in "security.apiKeys"
+	// authenticates.
+	apiKeys []apiKey
+	apiKey  struct {
+		Key       string
+		Principal string
+	}
+)
+
+func newApiKeys(cfg *viper.Viper) apiKeys {
+	keys := apiKeys{}
+	if err := cfg.UnmarshalKey("security.apiKeys", &keys); err != nil {
+		panic(fmt.Sprintf("invalid api keys: %v", err))
+	}
+	for _, k := range keys {
+		if k.Key == "" || k.Principal == "" || k.Principal == domain.ANONYMOUS_PRINCIPAL {
+			panic(fmt.Sprintf("invalid api key for principal %q", k.Principal))
+		}
+	}
+
+	return keys
+}
+
+// authenticate returns the principal of key, or ANONYMOUS_PRINCIPAL when
+// it isn't one of the keys.
+func (ks apiKeys) authenticate(key string) string {
+	principal := domain.ANONYMOUS_PRINCIPAL
+	for _, k := range ks {
+		if subtle.ConstantTimeCompare([]byte(k.Key), []byte(key)) == 1 {
+			principal = k.Principal
+		}
+	}
+
+	return principal
+}
+
+// withPrincipal puts the principal key authenticates on ctx.
+func (ks apiKeys) withPrincipal(ctx context.Context, key string) context.Context {
+	return domain.WithPrincipal(ctx, ks.authenticate(key))
+}
+
+// ApiKeyMiddleware puts the principal the X-API-Key header authenticates
+// on the context. Requests without a key, or with one that isn't in
+// "security.apiKeys", go on as ANONYMOUS_PRINCIPAL.
+func ApiKeyMiddleware(cfg *viper.Viper) gin.HandlerFunc {
+	keys := newApiKeys(cfg)
+
+	return func(c *gin.Context) {
+		c.Request = c.Request.WithContext(keys.withPrincipal(c.Request.Context(), c.GetHeader(apiKeyHeader)))
+		c.Next()
+	}
+}
@@ -0,0 +1,897 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/adapters/pb"
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"golang.org/x/exp/slog"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+//go:generate protoc -I ../../api/proto --go_out=pb --go_opt=paths=source_relative --go-grpc_out=pb --go-grpc_opt=paths=source_relative assets_balancer.proto
+
+const (
+	actorMetadataKey  = "x-actor"
+	apiKeyMetadataKey = "x-api-key"
+)
+
+type (
+	// AssetsBalancerGrpcServer serves the AssetBalancerUseCase over gRPC,
+	// validating its inputs with the same rules as the HTTP handlers.
+	AssetsBalancerGrpcServer struct {
+		pb.UnimplementedAssetsBalancerServer
+		useCase ports.AssetBalancerUseCase
+		broker  ports.GroupUpdateBroker
+	}
+	GrpcServer struct {
+		server          *grpc.Server
+		address         string
+		shutdownTimeout time.Duration
+	}
+	callerServerStream struct {
+		grpc.ServerStream
+		ctx context.Context
+	}
+)
+
+func NewAssetsBalancerGrpcServer(
+	uc ports.AssetBalancerUseCase,
+	broker ports.GroupUpdateBroker) *AssetsBalancerGrpcServer {
+	return &AssetsBalancerGrpcServer{
+		useCase: uc,
+		broker:  broker,
+	}
+}
+
+func (s *AssetsBalancerGrpcServer) GetAssetsGroups(
+	ctx context.Context, req *pb.GetAssetsGroupsRequest) (*pb.GetAssetsGroupsResponse, error) {
+	res := &pb.GetAssetsGroupsResponse{}
+	for _, g := range s.useCase.GetAssetsGroups(ctx) {
+		res.Groups = append(res.Groups, toPbAssetsGroup(g))
+	}
+
+	return res, nil
+}
+
+func (s *AssetsBalancerGrpcServer) GetAssetsGroup(
+	ctx context.Context, req *pb.GetAssetsGroupRequest) (*pb.AssetsGroup, error) {
+	id, err := parseGrpcId("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	group := s.useCase.GetAssetsGroup(ctx, &boundaries.GetAssetsGroupInput{
+		Id: id,
+	})
+	if group == nil {
+		return nil, status.Error(codes.NotFound, domain.ASSETS_GROUP_NOT_FOUND)
+	}
+
+	return toPbAssetsGroup(group), nil
+}
+
+func (s *AssetsBalancerGrpcServer) CreateAssetsGroup(
+	ctx context.Context, req *pb.CreateAssetsGroupRequest) (*pb.AssetsGroup, error) {
+	input := &boundaries.CreateAssetsGroupInput{
+		Label:             req.Label,
+		ContributionTotal: req.ContributionTotal,
+	}
+	for _, a := range req.Assets {
+		input.Assets = append(input.Assets, boundaries.CreateAssetInput{
+			Label:         a.Label,
+			Ticker:        a.Ticker,
+			Quantity:      a.Quantity,
+			Score:         a.Score,
+			PreviousValue: a.PreviousValue,
+			CurrentValue:  a.CurrentValue,
+			Include:       a.Include,
+		})
+	}
+	if err := validateGrpcInput(input); err != nil {
+		return nil, err
+	}
+
+	return toPbAssetsGroupResult(s.useCase.CreateAssetsGroup(ctx, input))
+}
+
+func (s *AssetsBalancerGrpcServer) UpdateAssetsGroup(
+	ctx context.Context, req *pb.UpdateAssetsGroupRequest) (*pb.AssetsGroup, error) {
+	id, err := parseGrpcId("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	input := &boundaries.UpdateAssetsGroup{
+		Id:                id,
+		ContributionTotal: req.ContributionTotal,
+		Label:             req.Label,
+		RebalanceMode:     req.RebalanceMode,
+		TaxAware:          req.TaxAware,
+		LotSelection:      req.LotSelection,
+	}
+	if err := validateGrpcInput(input); err != nil {
+		return nil, err
+	}
+
+	return toPbAssetsGroupResult(s.useCase.UpdateAssetsGroup(ctx, input))
+}
+
+func (s *AssetsBalancerGrpcServer) DeleteAssetsGroup(
+	ctx context.Context, req *pb.DeleteAssetsGroupRequest) (*emptypb.Empty, error) {
+	id, err := parseGrpcId("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.useCase.DeleteAssetsGroup(ctx, &boundaries.DeleteAssetsGroupInput{
+		Id: id,
+	}); err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *AssetsBalancerGrpcServer) CreateAsset(
+	ctx context.Context, req *pb.CreateAssetRequest) (*pb.AssetsGroup, error) {
+	groupId, err := parseGrpcId("group_id", req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	a := req.Asset
+	if a == nil {
+		a = &pb.CreateAssetInput{}
+	}
+	input := &boundaries.CreateAssetForGroupInput{
+		GroupId:       groupId,
+		Label:         a.Label,
+		Ticker:        a.Ticker,
+		Quantity:      a.Quantity,
+		Score:         a.Score,
+		PreviousValue: a.PreviousValue,
+		CurrentValue:  a.CurrentValue,
+		Include:       a.Include,
+	}
+	if err := validateGrpcInput(input); err != nil {
+		return nil, err
+	}
+
+	return toPbAssetsGroupResult(s.useCase.CreateAsset(ctx, input))
+}
+
+func (s *AssetsBalancerGrpcServer) UpdateAsset(
+	ctx context.Context, req *pb.UpdateAssetRequest) (*pb.AssetsGroup, error) {
+	id, err := parseGrpcId("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	groupId, err := parseGrpcId("group_id", req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	input := &boundaries.UpdateAssetInput{
+		Id:            id,
+		GroupId:       groupId,
+		Label:         req.Label,
+		Ticker:        req.Ticker,
+		Quantity:      req.Quantity,
+		Score:         req.Score,
+		PreviousValue: req.PreviousValue,
+		CurrentValue:  req.CurrentValue,
+		Include:       req.Include,
+	}
+	if err := validateGrpcInput(input); err != nil {
+		return nil, err
+	}
+
+	return toPbAssetsGroupResult(s.useCase.UpdateAsset(ctx, input))
+}
+
+func (s *AssetsBalancerGrpcServer) DeleteAsset(
+	ctx context.Context, req *pb.DeleteAssetRequest) (*pb.AssetsGroup, error) {
+	id, err := parseGrpcId("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	groupId, err := parseGrpcId("group_id", req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPbAssetsGroupResult(s.useCase.DeleteAsset(ctx, &boundaries.DeleteAssetInput{
+		Id:      id,
+		GroupId: groupId,
+	}))
+}
+
+func (s *AssetsBalancerGrpcServer) ApplyBatch(
+	ctx context.Context, req *pb.ApplyBatchRequest) (*pb.ApplyBatchResponse, error) {
+	groupId, err := parseGrpcId("group_id", req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	input := &boundaries.BatchAssetsInput{
+		GroupId: groupId,
+	}
+	for _, op := range req.Operations {
+		var assetId uuid.UUID
+		if op.AssetId != "" {
+			if assetId, err = parseGrpcId("asset_id", op.AssetId); err != nil {
+				return nil, err
+			}
+		}
+		input.Operations = append(input.Operations, &boundaries.BatchOperation{
+			Op:            op.Op,
+			AssetId:       assetId,
+			Label:         op.Label,
+			Ticker:        op.Ticker,
+			Quantity:      op.Quantity,
+			Score:         op.Score,
+			PreviousValue: op.PreviousValue,
+			CurrentValue:  op.CurrentValue,
+			Include:       op.Include,
+		})
+	}
+	if err := validateGrpcInput(input); err != nil {
+		return nil, err
+	}
+
+	res, err := s.useCase.ApplyBatch(ctx, input)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	out := &pb.ApplyBatchResponse{
+		Applied: res.Applied,
+	}
+	for _, r := range res.Results {
+		out.Results = append(out.Results, &pb.BatchOperationResult{
+			Index:   int32(r.Index),
+			Op:      r.Op,
+			AssetId: r.AssetId.String(),
+			Error:   r.Error,
+		})
+	}
+	if res.Group != nil {
+		out.Group = &pb.AssetsGroup{
+			Id:                      res.Group.Id.String(),
+			Label:                   res.Group.Label,
+			ContributionTotal:       res.Group.ContributionTotal,
+			UnallocatedContribution: res.Group.UnallocatedContribution,
+			Owner:                   res.Group.Owner,
+			Version:                 res.Group.Version,
+		}
+		for _, a := range res.Group.Assets {
+			out.Group.Assets = append(out.Group.Assets, &pb.Asset{
+				Id:                  a.Id.String(),
+				Label:               a.Label,
+				Ticker:              a.Ticker,
+				Quantity:            a.Quantity,
+				Score:               a.Score,
+				PreviousValue:       a.PreviousValue,
+				CurrentValue:        a.CurrentValue,
+				ValueVariation:      a.ValueVariation,
+				PercentageFromTotal: a.PercentageFromTotal,
+				FinalContribution:   a.FinalContribution,
+				Include:             a.Include,
+			})
+		}
+	}
+
+	return out, nil
+}
+
+func (s *AssetsBalancerGrpcServer) CloneAssetsGroup(
+	ctx context.Context, req *pb.CloneAssetsGroupRequest) (*pb.AssetsGroup, error) {
+	id, err := parseGrpcId("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	input := &boundaries.CloneAssetsGroupInput{
+		Id:    id,
+		Label: req.Label,
+	}
+	if err := validateGrpcInput(input); err != nil {
+		return nil, err
+	}
+
+	return toPbAssetsGroupResult(s.useCase.CloneAssetsGroup(ctx, input))
+}
+
+func (s *AssetsBalancerGrpcServer) GetDeletedAssetsGroups(
+	ctx context.Context, req *pb.GetDeletedAssetsGroupsRequest) (*pb.GetAssetsGroupsResponse, error) {
+	res := &pb.GetAssetsGroupsResponse{}
+	for _, g := range s.useCase.GetDeletedAssetsGroups(ctx) {
+		res.Groups = append(res.Groups, toPbAssetsGroup(g))
+	}
+
+	return res, nil
+}
+
+func (s *AssetsBalancerGrpcServer) GetDeletedAssets(
+	ctx context.Context, req *pb.GetDeletedAssetsRequest) (*pb.GetDeletedAssetsResponse, error) {
+	groupId, err := parseGrpcId("group_id", req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	assets, err := s.useCase.GetDeletedAssets(ctx, &boundaries.GetAssetsGroupInput{
+		Id: groupId,
+	})
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	res := &pb.GetDeletedAssetsResponse{}
+	for _, a := range assets {
+		res.Assets = append(res.Assets, toPbAsset(a))
+	}
+
+	return res, nil
+}
+
+func (s *AssetsBalancerGrpcServer) RestoreAssetsGroup(
+	ctx context.Context, req *pb.RestoreAssetsGroupRequest) (*pb.AssetsGroup, error) {
+	id, err := parseGrpcId("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPbAssetsGroupResult(s.useCase.RestoreAssetsGroup(ctx, &boundaries.RestoreAssetsGroupInput{
+		Id: id,
+	}))
+}
+
+func (s *AssetsBalancerGrpcServer) RestoreAsset(
+	ctx context.Context, req *pb.RestoreAssetRequest) (*pb.AssetsGroup, error) {
+	id, groupId, err := parseGrpcAssetIds(req.Id, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPbAssetsGroupResult(s.useCase.RestoreAsset(ctx, &boundaries.RestoreAssetInput{
+		Id:      id,
+		GroupId: groupId,
+	}))
+}
+
+func (s *AssetsBalancerGrpcServer) SetGlidePath(
+	ctx context.Context, req *pb.SetGlidePathRequest) (*pb.AssetsGroup, error) {
+	id, groupId, err := parseGrpcAssetIds(req.Id, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	input := &boundaries.SetGlidePathInput{
+		Id:            id,
+		GroupId:       groupId,
+		Interpolation: req.Interpolation,
+	}
+	for _, p := range req.Points {
+		input.Points = append(input.Points, boundaries.GlidePathPointInput{
+			At:    fromPbTime(p.At),
+			Score: p.Score,
+		})
+	}
+	if err := validateGrpcInput(input); err != nil {
+		return nil, err
+	}
+
+	return toPbAssetsGroupResult(s.useCase.SetGlidePath(ctx, input))
+}
+
+func (s *AssetsBalancerGrpcServer) DeleteGlidePath(
+	ctx context.Context, req *pb.DeleteGlidePathRequest) (*pb.AssetsGroup, error) {
+	id, groupId, err := parseGrpcAssetIds(req.Id, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPbAssetsGroupResult(s.useCase.DeleteGlidePath(ctx, &boundaries.DeleteGlidePathInput{
+		Id:      id,
+		GroupId: groupId,
+	}))
+}
+
+func (s *AssetsBalancerGrpcServer) PreviewGlidePath(
+	ctx context.Context, req *pb.PreviewGlidePathRequest) (*pb.PreviewGlidePathResponse, error) {
+	groupId, err := parseGrpcId("group_id", req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	input := &boundaries.PreviewGlidePathInput{
+		GroupId:    groupId,
+		From:       fromPbTime(req.From),
+		To:         fromPbTime(req.To),
+		StepMonths: int(req.StepMonths),
+	}
+	if err := validateGrpcInput(input); err != nil {
+		return nil, err
+	}
+
+	previews, err := s.useCase.PreviewGlidePath(ctx, input)
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+	res := &pb.PreviewGlidePathResponse{}
+	for _, p := range previews {
+		preview := &pb.GlidePathPreview{At: timestamppb.New(p.At)}
+		for _, t := range p.Targets {
+			preview.Targets = append(preview.Targets, &pb.AssetTarget{
+				AssetId: t.AssetId.String(),
+				Label:   t.Label,
+				Score:   t.Score,
+			})
+		}
+		res.Previews = append(res.Previews, preview)
+	}
+
+	return res, nil
+}
+
+func (s *AssetsBalancerGrpcServer) SetAssetConstraints(
+	ctx context.Context, req *pb.SetAssetConstraintsRequest) (*pb.AssetsGroup, error) {
+	id, groupId, err := parseGrpcAssetIds(req.Id, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	input := &boundaries.SetAssetConstraintsInput{
+		Id:      id,
+		GroupId: groupId,
+	}
+	if c := req.Constraints; c != nil {
+		input.MinWeight = c.MinWeight
+		input.MaxWeight = c.MaxWeight
+		input.MinTrade = c.MinTrade
+		input.NeverSell = c.NeverSell
+		input.NeverBuy = c.NeverBuy
+	}
+	if err := validateGrpcInput(input); err != nil {
+		return nil, err
+	}
+
+	return toPbAssetsGroupResult(s.useCase.SetAssetConstraints(ctx, input))
+}
+
+func (s *AssetsBalancerGrpcServer) DeleteAssetConstraints(
+	ctx context.Context, req *pb.DeleteAssetConstraintsRequest) (*pb.AssetsGroup, error) {
+	id, groupId, err := parseGrpcAssetIds(req.Id, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPbAssetsGroupResult(s.useCase.DeleteAssetConstraints(ctx, &boundaries.DeleteAssetConstraintsInput{
+		Id:      id,
+		GroupId: groupId,
+	}))
+}
+
+func (s *AssetsBalancerGrpcServer) SetTradingCosts(
+	ctx context.Context, req *pb.SetTradingCostsRequest) (*pb.AssetsGroup, error) {
+	id, groupId, err := parseGrpcAssetIds(req.Id, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	input := &boundaries.SetTradingCostsInput{
+		Id:      id,
+		GroupId: groupId,
+	}
+	if c := req.TradingCosts; c != nil {
+		input.FixedCost = c.FixedCost
+		input.ProportionalCost = c.ProportionalCost
+		input.LotSize = c.LotSize
+	}
+	if err := validateGrpcInput(input); err != nil {
+		return nil, err
+	}
+
+	return toPbAssetsGroupResult(s.useCase.SetTradingCosts(ctx, input))
+}
+
+func (s *AssetsBalancerGrpcServer) DeleteTradingCosts(
+	ctx context.Context, req *pb.DeleteTradingCostsRequest) (*pb.AssetsGroup, error) {
+	id, groupId, err := parseGrpcAssetIds(req.Id, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPbAssetsGroupResult(s.useCase.DeleteTradingCosts(ctx, &boundaries.DeleteTradingCostsInput{
+		Id:      id,
+		GroupId: groupId,
+	}))
+}
+
+func (s *AssetsBalancerGrpcServer) AddTaxLot(
+	ctx context.Context, req *pb.AddTaxLotRequest) (*pb.AssetsGroup, error) {
+	assetId, groupId, err := parseGrpcAssetIds(req.AssetId, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	input := &boundaries.AddTaxLotInput{
+		AssetId:    assetId,
+		GroupId:    groupId,
+		Quantity:   req.Quantity,
+		CostBasis:  req.CostBasis,
+		AcquiredAt: fromPbTime(req.AcquiredAt),
+	}
+	if err := validateGrpcInput(input); err != nil {
+		return nil, err
+	}
+
+	return toPbAssetsGroupResult(s.useCase.AddTaxLot(ctx, input))
+}
+
+func (s *AssetsBalancerGrpcServer) DeleteTaxLot(
+	ctx context.Context, req *pb.DeleteTaxLotRequest) (*pb.AssetsGroup, error) {
+	id, err := parseGrpcId("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	assetId, groupId, err := parseGrpcAssetIds(req.AssetId, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+
+	return toPbAssetsGroupResult(s.useCase.DeleteTaxLot(ctx, &boundaries.DeleteTaxLotInput{
+		Id:      id,
+		AssetId: assetId,
+		GroupId: groupId,
+	}))
+}
+
+// WatchAssetsGroup sends the group, then every saved state of it through
+// the group update broker, like the HTTP stream does.
+func (s *AssetsBalancerGrpcServer) WatchAssetsGroup(
+	req *pb.WatchAssetsGroupRequest, stream pb.AssetsBalancer_WatchAssetsGroupServer) error {
+	id, err := parseGrpcId("id", req.Id)
+	if err != nil {
+		return err
+	}
+	ctx := stream.Context()
+
+	updates, err := s.broker.Subscribe(ctx, id)
+	if err != nil {
+		return status.Error(codes.Unavailable, err.Error())
+	}
+	group := s.useCase.GetAssetsGroup(ctx, &boundaries.GetAssetsGroupInput{
+		Id: id,
+	})
+	if group == nil {
+		return status.Error(codes.NotFound, domain.ASSETS_GROUP_NOT_FOUND)
+	}
+	if err := stream.Send(toPbAssetsGroup(group)); err != nil {
+		return err
+	}
+
+	for g := range updates {
+		if err := stream.Send(toPbAssetsGroup(g)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func NewGrpcServer(cfg *viper.Viper, abs *AssetsBalancerGrpcServer, pts *PortfolioTemplateGrpcServer) *GrpcServer {
+	keys := newApiKeys(cfg)
+	server := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(recoveryUnaryInterceptor, keys.callerUnaryInterceptor),
+		grpc.ChainStreamInterceptor(recoveryStreamInterceptor, keys.callerStreamInterceptor))
+	pb.RegisterAssetsBalancerServer(server, abs)
+	pb.RegisterPortfolioTemplatesServer(server, pts)
+
+	shutdownTimeout := cfg.GetDuration("server.shutdownTimeout")
+	if shutdownTimeout <= 0 {
+		shutdownTimeout = 30 * time.Second
+	}
+
+	return &GrpcServer{
+		server:          server,
+		address:         cfg.GetString("grpc.address"),
+		shutdownTimeout: shutdownTimeout,
+	}
+}
+
+// Start serves on the configured address until ctx is done, then waits
+// up to the shutdown timeout for pending calls before cancelling the
+// rest, watch streams included. An empty address disables the gRPC API.
+func (gs *GrpcServer) Start(ctx context.Context) {
+	if gs.address == "" {
+		return
+	}
+	l, err := net.Listen("tcp", gs.address)
+	if err != nil {
+		slog.ErrorCtx(ctx, "grpc server failed to listen", "address", gs.address, "error", err)
+		return
+	}
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		<-ctx.Done()
+		drained := make(chan struct{})
+		go func() {
+			gs.server.GracefulStop()
+			close(drained)
+		}()
+		select {
+		case <-drained:
+		case <-time.After(gs.shutdownTimeout):
+			gs.server.Stop()
+		}
+	}()
+
+	if err := gs.server.Serve(l); err != nil {
+		slog.ErrorCtx(ctx, "grpc server stopped", "error", err)
+	}
+	<-stopped
+}
+
+// recoveryUnaryInterceptor answers a call that panicked with Internal,
+// like RecoveryMiddleware does with 500, instead of the panic taking the
+// whole server down.
+func recoveryUnaryInterceptor(ctx context.Context, req interface{},
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (_ interface{}, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoveredGrpcError(ctx, info.FullMethod, r)
+		}
+	}()
+	return handler(ctx, req)
+}
+
+func recoveryStreamInterceptor(srv interface{}, ss grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = recoveredGrpcError(ss.Context(), info.FullMethod, r)
+		}
+	}()
+	return handler(srv, ss)
+}
+
+func recoveredGrpcError(ctx context.Context, method string, r interface{}) error {
+	slog.ErrorCtx(ctx, "call panicked", "error", r, "method", method)
+	return status.Error(codes.Internal, codes.Internal.String())
+}
+
+// callerUnaryInterceptor names the caller from the x-actor metadata, like
+// ActorMiddleware does with the X-Actor header, and just as untrusted,
+// and authenticates it by the x-api-key metadata like ApiKeyMiddleware.
+func (ks apiKeys) callerUnaryInterceptor(ctx context.Context, req interface{},
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(ks.withGrpcCaller(ctx), req)
+}
+
+func (ks apiKeys) callerStreamInterceptor(srv interface{}, ss grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, &callerServerStream{ServerStream: ss, ctx: ks.withGrpcCaller(ss.Context())})
+}
+
+func (s *callerServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (ks apiKeys) withGrpcCaller(ctx context.Context) context.Context {
+	actor, key := "anonymous", ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(actorMetadataKey); len(v) > 0 && v[0] != "" {
+			actor = v[0]
+		}
+		if v := md.Get(apiKeyMetadataKey); len(v) > 0 {
+			key = v[0]
+		}
+	}
+
+	return ks.withPrincipal(domain.WithActor(ctx, actor), key)
+}
+
+func parseGrpcId(field, value string) (uuid.UUID, error) {
+	id, err := uuid.Parse(value)
+	if err != nil {
+		return uuid.Nil, status.Error(codes.InvalidArgument, field+" "+err.Error())
+	}
+
+	return id, nil
+}
+
+// parseGrpcAssetIds parses the ids of an asset and of its group.
+func parseGrpcAssetIds(assetId, groupId string) (uuid.UUID, uuid.UUID, error) {
+	id, err := parseGrpcId("id", assetId)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+	gid, err := parseGrpcId("group_id", groupId)
+	if err != nil {
+		return uuid.Nil, uuid.Nil, err
+	}
+
+	return id, gid, nil
+}
+
+// fromPbTime leaves an unset timestamp as the zero time, which the
+// inputs take as missing.
+func fromPbTime(ts *timestamppb.Timestamp) time.Time {
+	if ts == nil {
+		return time.Time{}
+	}
+	return ts.AsTime()
+}
+
+func toPbTime(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+func toPbId(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+	return id.String()
+}
+
+func validateGrpcInput(input interface{}) error {
+	if err := validateInput(input); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	return nil
+}
+
+// toGrpcError maps the errors the HTTP API answers with 412 to
+// FailedPrecondition, and the missing resources to NotFound.
+func toGrpcError(err error) error {
+	switch {
+	case errors.Is(err, domain.ErrAssetsGroupNotFound), errors.Is(err, domain.ErrAssetNotFound),
+		errors.Is(err, domain.ErrTemplateNotFound), errors.Is(err, domain.ErrTaxLotNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrTooManyAssets):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, domain.ErrTooManyAssetsGroups), errors.Is(err, domain.ErrTemplateNotOwned):
+		return status.Error(codes.PermissionDenied, err.Error())
+	case errors.Is(err, domain.ErrAssetsGroupConflict):
+		return status.Error(codes.Aborted, err.Error())
+	}
+
+	return status.Error(codes.FailedPrecondition, err.Error())
+}
+
+func toPbAssetsGroupResult(group *domain.AssetsGroup, err error) (*pb.AssetsGroup, error) {
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	return toPbAssetsGroup(group), nil
+}
+
+func toPbAssetsGroup(group *domain.AssetsGroup) *pb.AssetsGroup {
+	res := &pb.AssetsGroup{
+		Id:                      group.Id.String(),
+		Label:                   group.Label,
+		ContributionTotal:       group.ContributionTotal,
+		Owner:                   group.Owner,
+		DeletedAt:               toPbTime(group.DeletedAt),
+		TemplateId:              toPbId(group.TemplateId),
+		UnallocatedContribution: group.UnallocatedContribution,
+		RebalanceMode:           group.RebalanceMode,
+		TaxAware:                group.TaxAware,
+		LotSelection:            group.LotSelection,
+		EstimatedRealizedGain:   group.EstimatedRealizedGain,
+		Version:                 group.Version,
+	}
+	if o := group.Optimization; o != nil {
+		res.Optimization = &pb.OptimizationResult{
+			TrackingError:    o.TrackingError,
+			TransactionCosts: o.TransactionCosts,
+			Trades:           int32(o.Trades),
+			Moves:            int32(o.Moves),
+			Warning:          o.Warning,
+		}
+	}
+	for _, a := range group.Assets {
+		res.Assets = append(res.Assets, toPbAsset(a))
+	}
+	for _, cs := range group.ContributionSchedules {
+		res.ContributionSchedules = append(res.ContributionSchedules, &pb.ContributionSchedule{
+			Id:        cs.Id.String(),
+			Cron:      cs.Cron,
+			Amount:    cs.Amount,
+			NextRunAt: timestamppb.New(cs.NextRunAt),
+		})
+	}
+
+	return res
+}
+
+func toPbAsset(a *domain.Asset) *pb.Asset {
+	res := &pb.Asset{
+		Id:                  a.Id.String(),
+		Label:               a.Label,
+		Ticker:              a.Ticker,
+		Quantity:            a.Quantity,
+		Score:               a.Score,
+		PreviousValue:       a.PreviousValue,
+		CurrentValue:        a.CurrentValue,
+		ValueVariation:      a.ValueVariation,
+		PercentageFromTotal: a.PercentageFromTotal,
+		FinalContribution:   a.FinalContribution,
+		Include:             a.Include,
+		DeletedAt:           toPbTime(a.DeletedAt),
+		TemplateAssetId:     toPbId(a.TemplateAssetId),
+		TransactionCost:     a.TransactionCost,
+	}
+	if c := a.Constraints; c != nil {
+		res.Constraints = &pb.AssetConstraints{
+			MinWeight: c.MinWeight,
+			MaxWeight: c.MaxWeight,
+			MinTrade:  c.MinTrade,
+			NeverSell: c.NeverSell,
+			NeverBuy:  c.NeverBuy,
+		}
+	}
+	if b := a.Binding; b != nil {
+		res.Binding = &pb.ConstraintBinding{
+			Constraint:         b.Constraint,
+			TargetContribution: b.TargetContribution,
+			Explanation:        b.Explanation,
+		}
+	}
+	if c := a.TradingCosts; c != nil {
+		res.TradingCosts = &pb.TradingCosts{
+			FixedCost:        c.FixedCost,
+			ProportionalCost: c.ProportionalCost,
+			LotSize:          c.LotSize,
+		}
+	}
+	for _, tl := range a.TaxLots {
+		res.TaxLots = append(res.TaxLots, &pb.TaxLot{
+			Id:         tl.Id.String(),
+			Quantity:   tl.Quantity,
+			CostBasis:  tl.CostBasis,
+			AcquiredAt: timestamppb.New(tl.AcquiredAt),
+		})
+	}
+	if e := a.SaleEstimate; e != nil {
+		res.SaleEstimate = &pb.SaleEstimate{
+			Quantity:          e.Quantity,
+			Proceeds:          e.Proceeds,
+			CostBasis:         e.CostBasis,
+			RealizedGain:      e.RealizedGain,
+			ShortTermGain:     e.ShortTermGain,
+			LongTermGain:      e.LongTermGain,
+			UncoveredQuantity: e.UncoveredQuantity,
+		}
+		for _, ls := range e.Lots {
+			res.SaleEstimate.Lots = append(res.SaleEstimate.Lots, &pb.LotSale{
+				LotId:     ls.LotId.String(),
+				Quantity:  ls.Quantity,
+				CostBasis: ls.CostBasis,
+				Gain:      ls.Gain,
+				LongTerm:  ls.LongTerm,
+			})
+		}
+	}
+	if gp := a.GlidePath; gp != nil {
+		res.GlidePath = &pb.GlidePath{Interpolation: gp.Interpolation}
+		for _, p := range gp.Points {
+			res.GlidePath.Points = append(res.GlidePath.Points, &pb.GlidePathPoint{
+				At:    timestamppb.New(p.At),
+				Score: p.Score,
+			})
+		}
+	}
+
+	return res
+}
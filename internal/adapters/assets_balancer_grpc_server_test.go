@@ -0,0 +1,195 @@
+package adapters
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/adapters/pb"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func newGrpcTestClient(t *testing.T, group *domain.AssetsGroup,
+	broker ports.GroupUpdateBroker, journal *ChangeJournal) pb.AssetsBalancerClient {
+	return pb.NewAssetsBalancerClient(newGrpcTestConn(t, group, broker, journal))
+}
+
+func newGrpcTestConn(t *testing.T, group *domain.AssetsGroup,
+	broker ports.GroupUpdateBroker, journal *ChangeJournal) *grpc.ClientConn {
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		if filter["id"] != group.Id {
+			return nil
+		}
+		return group
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {}
+	s := NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, journal)
+	templates := newMockedInsertingRepository[*domain.PortfolioTemplate]()
+	templates.mockGetFirst = func(filter map[string]interface{}) *domain.PortfolioTemplate {
+		return nil
+	}
+	ts := NewPortfolioTemplateUseCase(viper.New(), templates, r, &mockedNotificationPublisher{}, journal)
+
+	return serveGrpcTest(t, NewGrpcServer(viper.New(), NewAssetsBalancerGrpcServer(s, broker), NewPortfolioTemplateGrpcServer(ts)))
+}
+
+func serveGrpcTest(t *testing.T, gs *GrpcServer) *grpc.ClientConn {
+	l := bufconn.Listen(1 << 20)
+	go gs.server.Serve(l)
+	t.Cleanup(gs.server.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return l.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func Test_Should_UpdateAssetOverGrpc(t *testing.T) {
+	assert := assert.New(t)
+	a := domain.NewAsset("RF", 50, 100, 100, 100, 0, true)
+	group := domain.NewAssetGroup("test", []*domain.Asset{a}, 0)
+	audit := newMockedInsertingRepository[*domain.AuditEntry]()
+	client := newGrpcTestClient(t, group, NewInProcessGroupUpdateBroker(), NewChangeJournal(&mockedTransactor{},
+		newMockedInsertingRepository[*domain.DomainEvent](), audit, NewInProcessGroupUpdateBroker()))
+	ctx := metadata.AppendToOutgoingContext(context.Background(), actorMetadataKey, "alice")
+
+	score := float32(60)
+	res, err := client.UpdateAsset(ctx, &pb.UpdateAssetRequest{
+		Id:      a.Id.String(),
+		GroupId: group.Id.String(),
+		Score:   &score,
+	})
+	if !assert.Nil(err) ||
+		!assert.EqualValues(60, res.Assets[0].Score) ||
+		!assert.EqualValues(100, res.Assets[0].CurrentValue) ||
+		!assert.Len(audit.mockedDatabase, 1) ||
+		!assert.Equal("alice", audit.mockedDatabase[0].Actor) {
+		t.FailNow()
+	}
+
+	score = 120
+	_, err = client.UpdateAsset(ctx, &pb.UpdateAssetRequest{
+		Id:      a.Id.String(),
+		GroupId: group.Id.String(),
+		Score:   &score,
+	})
+	assert.Equal(codes.InvalidArgument, status.Code(err))
+
+	_, err = client.GetAssetsGroup(ctx, &pb.GetAssetsGroupRequest{Id: uuid.NewString()})
+	assert.Equal(codes.NotFound, status.Code(err))
+}
+
+func Test_Should_AnswerPanickedCallsWithInternalOverGrpc(t *testing.T) {
+	assert := assert.New(t)
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		panic("connection lost")
+	}
+	s := NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, newMockedChangeJournal())
+	ts := NewPortfolioTemplateUseCase(viper.New(), newMockedRepository[*domain.PortfolioTemplate](), r,
+		&mockedNotificationPublisher{}, newMockedChangeJournal())
+	broker := NewInProcessGroupUpdateBroker()
+	client := pb.NewAssetsBalancerClient(serveGrpcTest(t,
+		NewGrpcServer(viper.New(), NewAssetsBalancerGrpcServer(s, broker), NewPortfolioTemplateGrpcServer(ts))))
+
+	_, err := client.GetAssetsGroup(context.Background(), &pb.GetAssetsGroupRequest{Id: uuid.NewString()})
+	assert.Equal(codes.Internal, status.Code(err))
+
+	// the server survives the panic
+	_, err = client.GetAssetsGroup(context.Background(), &pb.GetAssetsGroupRequest{Id: uuid.NewString()})
+	assert.Equal(codes.Internal, status.Code(err))
+}
+
+func Test_Should_StreamGroupUpdatesOverGrpc(t *testing.T) {
+	assert := assert.New(t)
+	group := domain.NewAssetGroup("test", []*domain.Asset{}, 0)
+	broker := NewInProcessGroupUpdateBroker()
+	client := newGrpcTestClient(t, group, broker, newMockedChangeJournal())
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	stream, err := client.WatchAssetsGroup(ctx, &pb.WatchAssetsGroupRequest{Id: group.Id.String()})
+	if !assert.Nil(err) {
+		t.FailNow()
+	}
+	first, err := stream.Recv()
+	if !assert.Nil(err) ||
+		!assert.Equal("test", first.Label) {
+		t.FailNow()
+	}
+
+	group.Label = "renamed"
+	broker.Publish(ctx, group)
+	second, err := stream.Recv()
+	if !assert.Nil(err) ||
+		!assert.Equal("renamed", second.Label) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_ManageTheTrashConstraintsAndTemplatesOverGrpc(t *testing.T) {
+	assert := assert.New(t)
+	kept := domain.NewAsset("RF", 50, 100, 100, 200, 0, true)
+	removed := domain.NewAsset("RV", 50, 100, 100, 200, 0, true)
+	group := domain.NewAssetGroup("test", []*domain.Asset{kept, removed}, 0)
+	group.TrashAssetAt(1, time.Now())
+	conn := newGrpcTestConn(t, group, NewInProcessGroupUpdateBroker(), newMockedChangeJournal())
+	client := pb.NewAssetsBalancerClient(conn)
+	ctx := context.Background()
+
+	deleted, err := client.GetDeletedAssets(ctx, &pb.GetDeletedAssetsRequest{GroupId: group.Id.String()})
+	if !assert.Nil(err) || !assert.Len(deleted.Assets, 1) {
+		t.FailNow()
+	}
+	assert.NotNil(deleted.Assets[0].DeletedAt)
+	res, err := client.RestoreAsset(ctx, &pb.RestoreAssetRequest{Id: removed.Id.String(), GroupId: group.Id.String()})
+	if !assert.Nil(err) || !assert.Len(res.Assets, 2) {
+		t.FailNow()
+	}
+
+	maxWeight := float32(40)
+	res, err = client.SetAssetConstraints(ctx, &pb.SetAssetConstraintsRequest{
+		Id:          kept.Id.String(),
+		GroupId:     group.Id.String(),
+		Constraints: &pb.AssetConstraints{MaxWeight: &maxWeight, NeverSell: true},
+	})
+	if assert.Nil(err) && assert.NotNil(res.Assets[0].Constraints) {
+		assert.EqualValues(40, res.Assets[0].Constraints.GetMaxWeight())
+		assert.True(res.Assets[0].Constraints.NeverSell)
+	}
+	_, err = client.AddTaxLot(ctx, &pb.AddTaxLotRequest{AssetId: uuid.NewString(), GroupId: group.Id.String(),
+		Quantity: 1, AcquiredAt: timestamppb.Now()})
+	assert.Equal(codes.NotFound, status.Code(err))
+
+	templates := pb.NewPortfolioTemplatesClient(conn)
+	template, err := templates.CreateTemplate(ctx, &pb.CreateTemplateRequest{
+		Label:  "60/40",
+		Assets: []*pb.TemplateAssetInput{{Label: "RV", Score: 60, Include: true}, {Label: "RF", Score: 40, Include: true}},
+	})
+	if assert.Nil(err) {
+		assert.Len(template.Assets, 2)
+	}
+	_, err = templates.GetTemplate(ctx, &pb.GetTemplateRequest{Id: uuid.NewString()})
+	assert.Equal(codes.NotFound, status.Code(err))
+}
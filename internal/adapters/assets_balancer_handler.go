@@ -1,21 +1,34 @@
 package adapters
 
 import (
+	"errors"
 	"net/http"
+	"reflect"
+	"strings"
 
 	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
 	"github.com/romaopatrick/assets-balancer/internal/ports"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
 	"github.com/google/uuid"
 )
 
 type (
 	AssetsBalancerHandler struct {
-		useCase ports.AssetBalancerUseCase
+		useCase            ports.AssetBalancerUseCase
+		revaluationUseCase ports.AssetsRevaluationUseCase
 	}
 	errorResult struct {
 		Errors []string
+		Fields []*fieldError `json:",omitempty"`
+	}
+	fieldError struct {
+		Field   string
+		Rule    string
+		Message string
 	}
 )
 
@@ -24,6 +37,98 @@ func newErrorResult(errs ...string) *errorResult {
 		Errors: errs,
 	}
 }
+
+// newBindingErrorResult reports each failed validation rule against the
+// field it applies to, e.g. "Assets[0].Score".
+func newBindingErrorResult(err error) *errorResult {
+	var verrs validator.ValidationErrors
+	if !errors.As(err, &verrs) {
+		return newErrorResult(err.Error())
+	}
+
+	res := &errorResult{}
+	for _, fe := range verrs {
+		field := fe.Namespace()
+		if i := strings.Index(field, "."); i >= 0 {
+			field = field[i+1:]
+		}
+		f := &fieldError{
+			Field:   field,
+			Rule:    fe.Tag(),
+			Message: validationMessage(fe),
+		}
+		res.Fields = append(res.Fields, f)
+		res.Errors = append(res.Errors, f.Field+" "+f.Message)
+	}
+
+	return res
+}
+
+// serviceErrorStatus answers 412 for a request the balancer rejects,
+// except for the limits: 413 when a group would hold too many assets and
+// 403 when its owner already has as many groups as allowed, and 409 when
+// the group was saved by someone else meanwhile.
+func serviceErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, domain.ErrAssetsGroupConflict):
+		return http.StatusConflict
+	case errors.Is(err, domain.ErrTooManyAssets):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, domain.ErrTooManyAssetsGroups), errors.Is(err, domain.ErrTemplateNotOwned):
+		return http.StatusForbidden
+	}
+	return http.StatusPreconditionFailed
+}
+
+// validateInput checks input against its binding rules, for the APIs
+// that don't bind it from an HTTP request.
+func validateInput(input interface{}) error {
+	if err := binding.Validator.ValidateStruct(input); err != nil {
+		return errors.New(strings.Join(newBindingErrorResult(err).Errors, "; "))
+	}
+
+	return nil
+}
+
+// bindJSONWithPath binds the body into input with the ids taken from the
+// path. They're set before binding so the required rules pass, and again
+// after so an id in the body can't override the path.
+func bindJSONWithPath(c *gin.Context, input interface{}, setIds func()) bool {
+	setIds()
+	if err := c.ShouldBindJSON(input); err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
+		return false
+	}
+	setIds()
+
+	return true
+}
+
+func validationMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "gt":
+		return "must be greater than " + fe.Param()
+	case "gte":
+		return "must be greater than or equal to " + fe.Param()
+	case "lt":
+		return "must be less than " + fe.Param()
+	case "lte":
+		return "must be less than or equal to " + fe.Param()
+	case "min":
+		if fe.Kind() == reflect.String {
+			return "must have at least " + fe.Param() + " characters"
+		}
+		return "must have at least " + fe.Param() + " items"
+	case "max":
+		return "must have at most " + fe.Param() + " items"
+	case "oneof":
+		return "must be one of: " + strings.ReplaceAll(fe.Param(), " ", ", ")
+	default:
+		return "failed on the " + fe.Tag() + " rule"
+	}
+}
 func (h *AssetsBalancerHandler) HandleGetAssetsGroups(c *gin.Context) {
 	res := h.useCase.GetAssetsGroups(c)
 
@@ -32,8 +137,14 @@ func (h *AssetsBalancerHandler) HandleGetAssetsGroups(c *gin.Context) {
 
 func (h *AssetsBalancerHandler) HandleGetAssetsGroup(c *gin.Context) {
 
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newErrorResult(err.Error()))
+		return
+	}
+
 	input := &boundaries.GetAssetsGroupInput{
-		Id: uuid.MustParse(c.Param("id")),
+		Id: id,
 	}
 	res := h.useCase.GetAssetsGroup(c, input)
 
@@ -44,14 +155,14 @@ func (h *AssetsBalancerHandler) HandleCreateAssetsGroup(c *gin.Context) {
 	input := &boundaries.CreateAssetsGroupInput{}
 
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newErrorResult(err.Error()))
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
 		return
 	}
 
 	res, err := h.useCase.CreateAssetsGroup(c, input)
 
 	if err != nil {
-		c.AbortWithStatusJSON(http.StatusPreconditionFailed, newErrorResult(err.Error()))
+		c.AbortWithStatusJSON(serviceErrorStatus(err), newErrorResult(err.Error()))
 		return
 	}
 
@@ -62,14 +173,14 @@ func (h *AssetsBalancerHandler) HandleCreateAsset(c *gin.Context) {
 	input := &boundaries.CreateAssetForGroupInput{}
 
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newErrorResult(err.Error()))
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
 		return
 	}
 
 	res, err := h.useCase.CreateAsset(c, input)
 
 	if err != nil {
-		c.AbortWithStatusJSON(http.StatusPreconditionFailed, newErrorResult(err.Error()))
+		c.AbortWithStatusJSON(serviceErrorStatus(err), newErrorResult(err.Error()))
 		return
 	}
 
@@ -80,14 +191,14 @@ func (h *AssetsBalancerHandler) HandleUpdateAsset(c *gin.Context) {
 	input := &boundaries.UpdateAssetInput{}
 
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newErrorResult(err.Error()))
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
 		return
 	}
 
 	res, err := h.useCase.UpdateAsset(c, input)
 
 	if err != nil {
-		c.AbortWithStatusJSON(http.StatusPreconditionFailed, newErrorResult(err.Error()))
+		c.AbortWithStatusJSON(serviceErrorStatus(err), newErrorResult(err.Error()))
 		return
 	}
 
@@ -98,14 +209,14 @@ func (h *AssetsBalancerHandler) HandleUpdateAssetsGroup(c *gin.Context) {
 	input := &boundaries.UpdateAssetsGroup{}
 
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newErrorResult(err.Error()))
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
 		return
 	}
 
 	res, err := h.useCase.UpdateAssetsGroup(c, input)
 
 	if err != nil {
-		c.AbortWithStatusJSON(http.StatusPreconditionFailed, newErrorResult(err.Error()))
+		c.AbortWithStatusJSON(serviceErrorStatus(err), newErrorResult(err.Error()))
 		return
 	}
 
@@ -116,14 +227,14 @@ func (h *AssetsBalancerHandler) HandleDeleteAsset(c *gin.Context) {
 	input := &boundaries.DeleteAssetInput{}
 
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newErrorResult(err.Error()))
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
 		return
 	}
 
 	res, err := h.useCase.DeleteAsset(c, input)
 
 	if err != nil {
-		c.AbortWithStatusJSON(http.StatusPreconditionFailed, newErrorResult(err.Error()))
+		c.AbortWithStatusJSON(serviceErrorStatus(err), newErrorResult(err.Error()))
 		return
 	}
 
@@ -134,22 +245,120 @@ func (h *AssetsBalancerHandler) HandleDeleteAssetsGroup(c *gin.Context) {
 	input := &boundaries.DeleteAssetsGroupInput{}
 
 	if err := c.ShouldBindJSON(&input); err != nil {
-		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newErrorResult(err.Error()))
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
 		return
 	}
 
 	err := h.useCase.DeleteAssetsGroup(c, input)
 
 	if err != nil {
-		c.AbortWithStatusJSON(http.StatusPreconditionFailed, newErrorResult(err.Error()))
+		c.AbortWithStatusJSON(serviceErrorStatus(err), newErrorResult(err.Error()))
 		return
 	}
 
 	c.Status(http.StatusOK)
 }
 
-func NewAssetsBalancerHandler(uc ports.AssetBalancerUseCase) *AssetsBalancerHandler {
+func (h *AssetsBalancerHandler) HandleGetDeletedAssetsGroups(c *gin.Context) {
+	res := h.useCase.GetDeletedAssetsGroups(c)
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *AssetsBalancerHandler) HandleGetDeletedAssets(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newErrorResult(err.Error()))
+		return
+	}
+
+	res, err := h.useCase.GetDeletedAssets(c, &boundaries.GetAssetsGroupInput{
+		Id: id,
+	})
+
+	if err != nil {
+		c.AbortWithStatusJSON(serviceErrorStatus(err), newErrorResult(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *AssetsBalancerHandler) HandleRestoreAssetsGroup(c *gin.Context) {
+	input := &boundaries.RestoreAssetsGroupInput{}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
+		return
+	}
+
+	res, err := h.useCase.RestoreAssetsGroup(c, input)
+
+	if err != nil {
+		c.AbortWithStatusJSON(serviceErrorStatus(err), newErrorResult(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *AssetsBalancerHandler) HandleRestoreAsset(c *gin.Context) {
+	input := &boundaries.RestoreAssetInput{}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
+		return
+	}
+
+	res, err := h.useCase.RestoreAsset(c, input)
+
+	if err != nil {
+		c.AbortWithStatusJSON(serviceErrorStatus(err), newErrorResult(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *AssetsBalancerHandler) HandleApplyBatch(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newErrorResult(err.Error()))
+		return
+	}
+	input := &boundaries.BatchAssetsInput{}
+
+	if !bindJSONWithPath(c, input, func() {
+		input.GroupId = id
+	}) {
+		return
+	}
+
+	res, err := h.useCase.ApplyBatch(c, input)
+
+	if err != nil {
+		c.AbortWithStatusJSON(serviceErrorStatus(err), newErrorResult(err.Error()))
+		return
+	}
+	if !res.Applied {
+		c.AbortWithStatusJSON(http.StatusPreconditionFailed, res)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *AssetsBalancerHandler) HandleRevaluateAssetsGroups(c *gin.Context) {
+	res := h.revaluationUseCase.RevaluateAssetsGroups(c)
+
+	c.JSON(http.StatusOK, res)
+}
+
+func NewAssetsBalancerHandler(
+	uc ports.AssetBalancerUseCase,
+	ruc ports.AssetsRevaluationUseCase) *AssetsBalancerHandler {
 	return &AssetsBalancerHandler{
-		useCase: uc,
+		useCase:            uc,
+		revaluationUseCase: ruc,
 	}
 }
@@ -2,199 +2,876 @@ package adapters
 
 import (
 	"context"
-	"errors"
+	"time"
 
 	"github.com/romaopatrick/assets-balancer/internal/boundaries"
 	"github.com/romaopatrick/assets-balancer/internal/domain"
 	"github.com/romaopatrick/assets-balancer/internal/ports"
 
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
 	"golang.org/x/exp/slices"
+	"golang.org/x/exp/slog"
 )
 
+// maxGlidePathPreviews caps the targets a preview lists, 50 years month
+// by month.
+const maxGlidePathPreviews = 600
+
 type (
 	AssetsBalancerService struct {
 		repository ports.Repository[*domain.AssetsGroup]
+		publisher  ports.NotificationPublisher
+		journal    *ChangeJournal
+		limits     *groupLimits
+	}
+	// groupLimits caps how large groups grow and how many of them an owner
+	// keeps. Zero means no limit.
+	groupLimits struct {
+		maxAssetsPerGroup int
+		maxGroupsPerOwner int
 	}
 )
 
-func NewAssetsBalancerUseCase(repository ports.Repository[*domain.AssetsGroup]) ports.AssetBalancerUseCase {
+func NewAssetsBalancerUseCase(
+	cfg *viper.Viper,
+	repository ports.Repository[*domain.AssetsGroup],
+	publisher ports.NotificationPublisher,
+	journal *ChangeJournal) ports.AssetBalancerUseCase {
 	return &AssetsBalancerService{
 		repository: repository,
+		publisher:  publisher,
+		journal:    journal,
+		limits:     newGroupLimits(cfg),
 	}
 }
 
 func (abs *AssetsBalancerService) GetAssetsGroups(ctx context.Context) []*domain.AssetsGroup {
-	result := abs.repository.GetAll(ctx, nil)
+	result := abs.repository.GetAll(ctx, notDeleted(map[string]interface{}{}))
 	return result
 }
 
 func (abs *AssetsBalancerService) GetAssetsGroup(
 	ctx context.Context, input *boundaries.GetAssetsGroupInput) *domain.AssetsGroup {
-	return abs.repository.GetFirst(ctx, map[string]interface{}{
+	return abs.repository.GetFirst(ctx, notDeleted(map[string]interface{}{
 		"id": input.Id,
+	}))
+}
+
+func (abs *AssetsBalancerService) GetDeletedAssetsGroups(ctx context.Context) []*domain.AssetsGroup {
+	return abs.repository.GetAll(ctx, map[string]interface{}{
+		"deletedat": map[string]interface{}{
+			"$ne": nil,
+		},
 	})
 }
 
+// GetDeletedAssets lists the trash of a group that isn't in the trash
+// itself.
+func (abs *AssetsBalancerService) GetDeletedAssets(
+	ctx context.Context, input *boundaries.GetAssetsGroupInput) ([]*domain.Asset, error) {
+	assetsGroup := abs.GetAssetsGroup(ctx, input)
+	if assetsGroup == nil {
+		return nil, domain.ErrAssetsGroupNotFound
+	}
+	if assetsGroup.DeletedAssets == nil {
+		return []*domain.Asset{}, nil
+	}
+
+	return assetsGroup.DeletedAssets, nil
+}
+
 func (abs *AssetsBalancerService) CreateAssetsGroup(
-	ctx context.Context, input *boundaries.CreateAssetsGroupInput) (*domain.AssetsGroup, error) {
+	ctx context.Context, input *boundaries.CreateAssetsGroupInput) (_ *domain.AssetsGroup, err error) {
+	defer func() { logMutation(ctx, "CreateAssetsGroup", err, "label", input.Label) }()
+	owner := domain.PrincipalFromContext(ctx)
+	if err := abs.limits.checkAssets(len(input.Assets)); err != nil {
+		return nil, err
+	}
 
 	assets := []*domain.Asset{}
 	for _, v := range input.Assets {
-		assets = append(assets, domain.NewAsset(
+		a := domain.NewAsset(
 			v.Label, v.Score, v.PreviousValue, v.CurrentValue,
-			input.CurrentTotal(), input.ContributionTotal, v.Include))
+			input.CurrentTotal(), input.ContributionTotal, v.Include)
+		a.Ticker = v.Ticker
+		a.Quantity = v.Quantity
+		assets = append(assets, a)
 	}
 	assetsGroup := domain.NewAssetGroup(input.Label, assets, input.ContributionTotal)
+	assetsGroup.Owner = owner
+	recordGroupCreated(assetsGroup)
 
-	abs.repository.Insert(ctx, assetsGroup)
+	if err := abs.journal.Save(ctx, assetsGroup, func(ctx context.Context) error {
+		if err := abs.limits.checkGroups(ctx, abs.repository, owner); err != nil {
+			return err
+		}
+		abs.repository.Insert(ctx, assetsGroup)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	publishGroupModified(ctx, abs.publisher, assetsGroup)
 
 	return assetsGroup, nil
 }
 
 func (abs *AssetsBalancerService) CreateAsset(
-	ctx context.Context, input *boundaries.CreateAssetForGroupInput) (*domain.AssetsGroup, error) {
+	ctx context.Context, input *boundaries.CreateAssetForGroupInput) (_ *domain.AssetsGroup, err error) {
+	defer func() { logMutation(ctx, "CreateAsset", err, "group_id", input.GroupId) }()
 
-	assetsGroup := abs.repository.GetFirst(ctx, map[string]interface{}{
+	assetsGroup := abs.repository.GetFirst(ctx, notDeleted(map[string]interface{}{
 		"id": input.GroupId,
-	})
+	}))
 
 	if assetsGroup == nil {
-		return nil, errors.New(domain.ASSETS_GROUP_NOT_FOUND)
+		return nil, domain.ErrAssetsGroupNotFound
+	}
+	if err := abs.limits.checkAssets(len(assetsGroup.Assets) + 1); err != nil {
+		return nil, err
 	}
 
-	total := assetsGroup.CurrentTotal() + input.CurrentValue
-	a := domain.NewAsset(input.Label,
-		input.Score, input.PreviousValue, input.CurrentValue,
-		total, assetsGroup.ContributionTotal, input.Include)
-
-	assetsGroup.Assets = append(assetsGroup.Assets, a)
-	balance(assetsGroup)
+	addAsset(assetsGroup, input)
+	balance(ctx, assetsGroup)
 
-	abs.repository.Replace(ctx, map[string]interface{}{
-		"id": input.GroupId,
-	}, assetsGroup)
+	if err := abs.replace(ctx, assetsGroup); err != nil {
+		return nil, err
+	}
+	publishGroupModified(ctx, abs.publisher, assetsGroup)
 
 	return assetsGroup, nil
 }
 
 func (abs *AssetsBalancerService) UpdateAssetsGroup(
-	ctx context.Context, input *boundaries.UpdateAssetsGroup) (*domain.AssetsGroup, error) {
-	assetsGroup := abs.repository.GetFirst(ctx, map[string]interface{}{
+	ctx context.Context, input *boundaries.UpdateAssetsGroup) (_ *domain.AssetsGroup, err error) {
+	defer func() { logMutation(ctx, "UpdateAssetsGroup", err, "group_id", input.Id) }()
+	assetsGroup := abs.repository.GetFirst(ctx, notDeleted(map[string]interface{}{
 		"id": input.Id,
-	})
+	}))
 
 	if assetsGroup == nil {
-		return nil, errors.New(domain.ASSETS_GROUP_NOT_FOUND)
+		return nil, domain.ErrAssetsGroupNotFound
 	}
 
-	if input.Label != "" {
-		assetsGroup.Label = input.Label
+	before := *assetsGroup
+	if input.Label != nil && *input.Label != assetsGroup.Label {
+		assetsGroup.Record(domain.AssetsGroupRenamed{
+			PreviousLabel: assetsGroup.Label,
+			Label:         *input.Label,
+		})
+		assetsGroup.Label = *input.Label
+	}
+	if input.ContributionTotal != nil && *input.ContributionTotal != assetsGroup.ContributionTotal {
+		assetsGroup.Record(domain.ContributionChanged{
+			PreviousContributionTotal: assetsGroup.ContributionTotal,
+			ContributionTotal:         *input.ContributionTotal,
+		})
+		assetsGroup.ContributionTotal = *input.ContributionTotal
+	}
+	if input.RebalanceMode != nil {
+		assetsGroup.RebalanceMode = *input.RebalanceMode
+	}
+	if input.TaxAware != nil {
+		assetsGroup.TaxAware = *input.TaxAware
 	}
-	if input.ContributionTotal != 0 {
-		assetsGroup.ContributionTotal = input.ContributionTotal
+	if input.LotSelection != nil {
+		assetsGroup.LotSelection = *input.LotSelection
 	}
-	balance(assetsGroup)
+	assetsGroup.RecordAudit(domain.AUDIT_UPDATE_ASSETS_GROUP, uuid.Nil,
+		domain.DiffAssetsGroups(&before, assetsGroup))
+	balance(ctx, assetsGroup)
 
-	abs.repository.Replace(ctx, map[string]interface{}{
-		"id": input.Id,
-	}, assetsGroup)
+	if err := abs.replace(ctx, assetsGroup); err != nil {
+		return nil, err
+	}
+	publishGroupModified(ctx, abs.publisher, assetsGroup)
 
 	return assetsGroup, nil
 
 }
 func (abs *AssetsBalancerService) UpdateAsset(
-	ctx context.Context, input *boundaries.UpdateAssetInput) (*domain.AssetsGroup, error) {
+	ctx context.Context, input *boundaries.UpdateAssetInput) (_ *domain.AssetsGroup, err error) {
+	defer func() { logMutation(ctx, "UpdateAsset", err, "group_id", input.GroupId, "asset_id", input.Id) }()
 
-	assetsGroup := abs.repository.GetFirst(ctx, map[string]interface{}{
+	assetsGroup := abs.repository.GetFirst(ctx, notDeleted(map[string]interface{}{
 		"id": input.GroupId, "assets": map[string]interface{}{
 			"$elemMatch": map[string]interface{}{
 				"id": input.Id,
 			},
 		},
-	})
+	}))
 
 	if assetsGroup == nil {
-		return nil, errors.New(domain.ASSETS_GROUP_NOT_FOUND)
+		return nil, domain.ErrAssetsGroupNotFound
 	}
 
 	idx := slices.IndexFunc(assetsGroup.Assets, func(a *domain.Asset) bool {
 		return a.Id == input.Id
 	})
+	if idx < 0 {
+		return nil, domain.ErrAssetNotFound
+	}
 
-	updateAsset(assetsGroup.Assets[idx], input)
-	balance(assetsGroup)
+	before := *assetsGroup.Assets[idx]
+	if err := updateAsset(assetsGroup.Assets[idx], input); err != nil {
+		return nil, err
+	}
+	assetsGroup.RecordAssetChanges(domain.AUDIT_UPDATE_ASSET, before, assetsGroup.Assets[idx])
+	balance(ctx, assetsGroup)
 
-	abs.repository.Replace(ctx, map[string]interface{}{
-		"id": input.GroupId,
-	}, assetsGroup)
+	if err := abs.replace(ctx, assetsGroup); err != nil {
+		return nil, err
+	}
+	publishGroupModified(ctx, abs.publisher, assetsGroup)
 
 	return assetsGroup, nil
 }
 
 func (abs *AssetsBalancerService) DeleteAsset(
-	ctx context.Context, input *boundaries.DeleteAssetInput) (*domain.AssetsGroup, error) {
-	assetsGroup := abs.repository.GetFirst(ctx, map[string]interface{}{
+	ctx context.Context, input *boundaries.DeleteAssetInput) (_ *domain.AssetsGroup, err error) {
+	defer func() { logMutation(ctx, "DeleteAsset", err, "group_id", input.GroupId, "asset_id", input.Id) }()
+	assetsGroup := abs.repository.GetFirst(ctx, notDeleted(map[string]interface{}{
 		"id": input.GroupId, "assets": map[string]interface{}{
 			"$elemMatch": map[string]interface{}{
 				"id": input.Id,
 			},
 		},
-	})
+	}))
 	if assetsGroup == nil {
-		return nil, errors.New(domain.ASSETS_GROUP_NOT_FOUND)
+		return nil, domain.ErrAssetsGroupNotFound
 	}
 
 	idx := slices.IndexFunc(assetsGroup.Assets, func(a *domain.Asset) bool {
 		return a.Id == input.Id
 	})
+	if idx < 0 {
+		return nil, domain.ErrAssetNotFound
+	}
 
-	assetsGroup.Assets = removeAsset(assetsGroup.Assets, idx)
-	balance(assetsGroup)
+	assetsGroup.TrashAssetAt(idx, time.Now())
+	balance(ctx, assetsGroup)
 
-	abs.repository.Replace(ctx, map[string]interface{}{
-		"id": input.GroupId,
-	}, assetsGroup)
+	if err := abs.replace(ctx, assetsGroup); err != nil {
+		return nil, err
+	}
+	publishGroupModified(ctx, abs.publisher, assetsGroup)
 
 	return assetsGroup, nil
 }
 
+// DeleteAssetsGroup moves the group to the trash. It's purged for good
+// once it has been there for the retention period.
 func (abs *AssetsBalancerService) DeleteAssetsGroup(
-	ctx context.Context, input *boundaries.DeleteAssetsGroupInput) error {
+	ctx context.Context, input *boundaries.DeleteAssetsGroupInput) (err error) {
+	defer func() { logMutation(ctx, "DeleteAssetsGroup", err, "group_id", input.Id) }()
 	assetsGroup := abs.repository.GetFirst(ctx,
-		map[string]interface{}{
+		notDeleted(map[string]interface{}{
 			"id": input.Id,
-		})
+		}))
 
 	if assetsGroup == nil {
-		return errors.New(domain.ASSETS_GROUP_NOT_FOUND)
+		return domain.ErrAssetsGroupNotFound
 	}
 
-	abs.repository.DeleteAll(ctx, map[string]interface{}{
+	assetsGroup.Trash(time.Now())
+	if err := abs.replace(ctx, assetsGroup); err != nil {
+		return err
+	}
+	publishGroupModified(ctx, abs.publisher, assetsGroup)
+
+	return nil
+}
+
+func (abs *AssetsBalancerService) RestoreAssetsGroup(
+	ctx context.Context, input *boundaries.RestoreAssetsGroupInput) (_ *domain.AssetsGroup, err error) {
+	defer func() { logMutation(ctx, "RestoreAssetsGroup", err, "group_id", input.Id) }()
+	assetsGroup := abs.repository.GetFirst(ctx, map[string]interface{}{
 		"id": input.Id,
+		"deletedat": map[string]interface{}{
+			"$ne": nil,
+		},
 	})
 
+	if assetsGroup == nil {
+		return nil, domain.ErrAssetsGroupNotFound
+	}
+
+	assetsGroup.Restore()
+	if err := abs.journal.Save(ctx, assetsGroup, func(ctx context.Context) error {
+		if err := abs.limits.checkGroups(ctx, abs.repository, assetsGroup.Owner); err != nil {
+			return err
+		}
+		return replaceGroup(ctx, abs.repository, assetsGroup)
+	}); err != nil {
+		return nil, err
+	}
+	publishGroupModified(ctx, abs.publisher, assetsGroup)
+
+	return assetsGroup, nil
+}
+
+func (abs *AssetsBalancerService) RestoreAsset(
+	ctx context.Context, input *boundaries.RestoreAssetInput) (_ *domain.AssetsGroup, err error) {
+	defer func() { logMutation(ctx, "RestoreAsset", err, "group_id", input.GroupId, "asset_id", input.Id) }()
+	assetsGroup := abs.repository.GetFirst(ctx, notDeleted(map[string]interface{}{
+		"id": input.GroupId,
+	}))
+
+	if assetsGroup == nil {
+		return nil, domain.ErrAssetsGroupNotFound
+	}
+	if err := abs.limits.checkAssets(len(assetsGroup.Assets) + 1); err != nil {
+		return nil, err
+	}
+	if assetsGroup.RestoreAsset(input.Id) == nil {
+		return nil, domain.ErrAssetNotFound
+	}
+	balance(ctx, assetsGroup)
+
+	if err := abs.replace(ctx, assetsGroup); err != nil {
+		return nil, err
+	}
+	publishGroupModified(ctx, abs.publisher, assetsGroup)
+
+	return assetsGroup, nil
+}
+
+// ApplyBatch runs the operations in order on a copy of the group and
+// saves it in a single go only when every one of them succeeds, so a
+// failed batch leaves the group untouched.
+func (abs *AssetsBalancerService) ApplyBatch(
+	ctx context.Context, input *boundaries.BatchAssetsInput) (res *boundaries.BatchAssetsResult, err error) {
+	defer func() {
+		failure := err
+		if failure == nil && !res.Applied {
+			failure = domain.ErrInvalidBatchOperation
+		}
+		logMutation(ctx, "ApplyBatch", failure, "group_id", input.GroupId)
+	}()
+	assetsGroup := abs.repository.GetFirst(ctx, notDeleted(map[string]interface{}{
+		"id": input.GroupId,
+	}))
+
+	if assetsGroup == nil {
+		return nil, domain.ErrAssetsGroupNotFound
+	}
+
+	work := assetsGroup.Clone()
+	res = &boundaries.BatchAssetsResult{Applied: true}
+	for i, op := range input.Operations {
+		r := &boundaries.BatchOperationResult{
+			Index:   i,
+			Op:      op.Op,
+			AssetId: op.AssetId,
+		}
+		if err := applyBatchOperation(work, op, r); err != nil {
+			r.Error = err.Error()
+			res.Applied = false
+		}
+		res.Results = append(res.Results, r)
+	}
+	if !res.Applied {
+		return res, nil
+	}
+	if err := abs.limits.checkAssets(len(work.Assets)); err != nil {
+		return nil, err
+	}
+	balance(ctx, work)
+
+	if err := abs.replace(ctx, work); err != nil {
+		return nil, err
+	}
+	publishGroupModified(ctx, abs.publisher, work)
+	res.Group = toBatchAssetsGroup(work)
+
+	return res, nil
+}
+
+func toBatchAssetsGroup(group *domain.AssetsGroup) *boundaries.BatchAssetsGroup {
+	res := &boundaries.BatchAssetsGroup{
+		Id:                      group.Id,
+		Label:                   group.Label,
+		ContributionTotal:       group.ContributionTotal,
+		UnallocatedContribution: group.UnallocatedContribution,
+		Owner:                   group.Owner,
+		Version:                 group.Version,
+	}
+	for _, a := range group.Assets {
+		res.Assets = append(res.Assets, &boundaries.BatchAsset{
+			Id:                  a.Id,
+			Label:               a.Label,
+			Ticker:              a.Ticker,
+			Quantity:            a.Quantity,
+			Score:               a.Score,
+			PreviousValue:       a.PreviousValue,
+			CurrentValue:        a.CurrentValue,
+			ValueVariation:      a.ValueVariation,
+			PercentageFromTotal: a.PercentageFromTotal,
+			FinalContribution:   a.FinalContribution,
+			Include:             a.Include,
+		})
+	}
+
+	return res
+}
+
+// CloneAssetsGroup copies a group for the principal of ctx. The copy stays
+// linked to the template of the group, if any.
+func (abs *AssetsBalancerService) CloneAssetsGroup(
+	ctx context.Context, input *boundaries.CloneAssetsGroupInput) (_ *domain.AssetsGroup, err error) {
+	defer func() { logMutation(ctx, "CloneAssetsGroup", err, "source_group_id", input.Id) }()
+	assetsGroup := abs.repository.GetFirst(ctx, notDeleted(map[string]interface{}{
+		"id": input.Id,
+	}))
+
+	if assetsGroup == nil {
+		return nil, domain.ErrAssetsGroupNotFound
+	}
+	owner := domain.PrincipalFromContext(ctx)
+	clone := assetsGroup.Duplicate(input.Label, owner)
+	balance(ctx, clone)
+	recordGroupCreated(clone)
+
+	if err := abs.journal.Save(ctx, clone, func(ctx context.Context) error {
+		if err := abs.limits.checkGroups(ctx, abs.repository, owner); err != nil {
+			return err
+		}
+		abs.repository.Insert(ctx, clone)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	publishGroupModified(ctx, abs.publisher, clone)
+
+	return clone, nil
+}
+
+// SetGlidePath gives the asset a score that follows the glide path,
+// starting with the one in effect now. The included assets without one
+// share what the glide paths of the group leave.
+func (abs *AssetsBalancerService) SetGlidePath(
+	ctx context.Context, input *boundaries.SetGlidePathInput) (_ *domain.AssetsGroup, err error) {
+	defer func() { logMutation(ctx, "SetGlidePath", err, "group_id", input.GroupId, "asset_id", input.Id) }()
+	points := []*domain.GlidePathPoint{}
+	for _, p := range input.Points {
+		points = append(points, &domain.GlidePathPoint{
+			At:    p.At,
+			Score: p.Score,
+		})
+	}
+	glidePath, err := domain.NewGlidePath(input.Interpolation, points)
+	if err != nil {
+		return nil, err
+	}
+
+	return abs.changeAsset(ctx, input.GroupId, input.Id, domain.AUDIT_SET_GLIDE_PATH, func(a *domain.Asset) error {
+		a.GlidePath = glidePath
+		return nil
+	})
+}
+
+// DeleteGlidePath stops the score of the asset from moving. It keeps the
+// score in effect until now.
+func (abs *AssetsBalancerService) DeleteGlidePath(
+	ctx context.Context, input *boundaries.DeleteGlidePathInput) (_ *domain.AssetsGroup, err error) {
+	defer func() { logMutation(ctx, "DeleteGlidePath", err, "group_id", input.GroupId, "asset_id", input.Id) }()
+	return abs.changeAsset(ctx, input.GroupId, input.Id, domain.AUDIT_DELETE_GLIDE_PATH, func(a *domain.Asset) error {
+		a.GlidePath = nil
+		return nil
+	})
+}
+
+// SetAssetConstraints replaces the constraints of the asset and
+// rebalances its group within them.
+func (abs *AssetsBalancerService) SetAssetConstraints(
+	ctx context.Context, input *boundaries.SetAssetConstraintsInput) (_ *domain.AssetsGroup, err error) {
+	defer func() { logMutation(ctx, "SetAssetConstraints", err, "group_id", input.GroupId, "asset_id", input.Id) }()
+	constraints, err := domain.NewAssetConstraints(input.MinWeight, input.MaxWeight,
+		input.MinTrade, input.NeverSell, input.NeverBuy)
+	if err != nil {
+		return nil, err
+	}
+
+	return abs.changeAsset(ctx, input.GroupId, input.Id, domain.AUDIT_SET_CONSTRAINTS, func(a *domain.Asset) error {
+		a.Constraints = constraints
+		return nil
+	})
+}
+
+func (abs *AssetsBalancerService) DeleteAssetConstraints(
+	ctx context.Context, input *boundaries.DeleteAssetConstraintsInput) (_ *domain.AssetsGroup, err error) {
+	defer func() {
+		logMutation(ctx, "DeleteAssetConstraints", err, "group_id", input.GroupId, "asset_id", input.Id)
+	}()
+	return abs.changeAsset(ctx, input.GroupId, input.Id, domain.AUDIT_DELETE_CONSTRAINTS, func(a *domain.Asset) error {
+		a.Constraints = nil
+		return nil
+	})
+}
+
+// SetTradingCosts replaces what trading the asset costs, which groups
+// in the OPTIMIZED mode weigh against their tracking error.
+func (abs *AssetsBalancerService) SetTradingCosts(
+	ctx context.Context, input *boundaries.SetTradingCostsInput) (_ *domain.AssetsGroup, err error) {
+	defer func() { logMutation(ctx, "SetTradingCosts", err, "group_id", input.GroupId, "asset_id", input.Id) }()
+	costs, err := domain.NewTradingCosts(input.FixedCost, input.ProportionalCost, input.LotSize)
+	if err != nil {
+		return nil, err
+	}
+
+	return abs.changeAsset(ctx, input.GroupId, input.Id, domain.AUDIT_SET_TRADING_COSTS, func(a *domain.Asset) error {
+		a.TradingCosts = costs
+		return nil
+	})
+}
+
+func (abs *AssetsBalancerService) DeleteTradingCosts(
+	ctx context.Context, input *boundaries.DeleteTradingCostsInput) (_ *domain.AssetsGroup, err error) {
+	defer func() { logMutation(ctx, "DeleteTradingCosts", err, "group_id", input.GroupId, "asset_id", input.Id) }()
+	return abs.changeAsset(ctx, input.GroupId, input.Id, domain.AUDIT_DELETE_TRADING_COSTS, func(a *domain.Asset) error {
+		a.TradingCosts = nil
+		return nil
+	})
+}
+
+// AddTaxLot records a purchase of the asset still held, appending it
+// last to its lots. The lots can't hold more than the quantity of the
+// asset.
+func (abs *AssetsBalancerService) AddTaxLot(
+	ctx context.Context, input *boundaries.AddTaxLotInput) (_ *domain.AssetsGroup, err error) {
+	defer func() { logMutation(ctx, "AddTaxLot", err, "group_id", input.GroupId, "asset_id", input.AssetId) }()
+	lot, err := domain.NewTaxLot(input.Quantity, input.CostBasis, input.AcquiredAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return abs.changeAsset(ctx, input.GroupId, input.AssetId, domain.AUDIT_ADD_TAX_LOT, func(a *domain.Asset) error {
+		return a.AddTaxLot(lot)
+	})
+}
+
+func (abs *AssetsBalancerService) DeleteTaxLot(
+	ctx context.Context, input *boundaries.DeleteTaxLotInput) (_ *domain.AssetsGroup, err error) {
+	defer func() {
+		logMutation(ctx, "DeleteTaxLot", err, "group_id", input.GroupId, "asset_id", input.AssetId, "tax_lot_id", input.Id)
+	}()
+	return abs.changeAsset(ctx, input.GroupId, input.AssetId, domain.AUDIT_DELETE_TAX_LOT, func(a *domain.Asset) error {
+		if !a.RemoveTaxLot(input.Id) {
+			return domain.ErrTaxLotNotFound
+		}
+		return nil
+	})
+}
+
+// changeAsset applies change to an asset of the group, then rebalances
+// and saves the group.
+func (abs *AssetsBalancerService) changeAsset(ctx context.Context,
+	groupId, assetId uuid.UUID, operation string, change func(a *domain.Asset) error) (*domain.AssetsGroup, error) {
+	assetsGroup := abs.repository.GetFirst(ctx, notDeleted(map[string]interface{}{
+		"id": groupId,
+	}))
+
+	if assetsGroup == nil {
+		return nil, domain.ErrAssetsGroupNotFound
+	}
+
+	a := findAsset(assetsGroup, assetId)
+	if a == nil {
+		return nil, domain.ErrAssetNotFound
+	}
+
+	before := *a
+	if err := change(a); err != nil {
+		return nil, err
+	}
+	assetsGroup.RecordAssetChanges(operation, before, a)
+	balance(ctx, assetsGroup)
+
+	if err := abs.replace(ctx, assetsGroup); err != nil {
+		return nil, err
+	}
+	publishGroupModified(ctx, abs.publisher, assetsGroup)
+
+	return assetsGroup, nil
+}
+
+// PreviewGlidePath lists the targets of the group every few months over
+// the range asked for, and at its end.
+func (abs *AssetsBalancerService) PreviewGlidePath(
+	ctx context.Context, input *boundaries.PreviewGlidePathInput) ([]*domain.GlidePathPreview, error) {
+	assetsGroup := abs.GetAssetsGroup(ctx, &boundaries.GetAssetsGroupInput{
+		Id: input.GroupId,
+	})
+
+	if assetsGroup == nil {
+		return nil, domain.ErrAssetsGroupNotFound
+	}
+
+	from, to, step := input.From, input.To, input.StepMonths
+	if from.IsZero() {
+		from = time.Now()
+	}
+	if to.IsZero() {
+		to = assetsGroup.GlidePathsEnd()
+		if to.Before(from) {
+			to = from
+		}
+	}
+	if step == 0 {
+		step = 12
+	}
+	if step < 0 || to.Before(from) {
+		return nil, domain.ErrInvalidPreviewRange
+	}
+
+	previews := []*domain.GlidePathPreview{}
+	at := from
+	for i := 1; !at.After(to); i++ {
+		if len(previews) == maxGlidePathPreviews {
+			return nil, domain.ErrInvalidPreviewRange
+		}
+		previews = append(previews, assetsGroup.TargetsAt(at))
+		at = from.AddDate(0, i*step, 0)
+	}
+	if last := previews[len(previews)-1].At; last.Before(to) {
+		previews = append(previews, assetsGroup.TargetsAt(to))
+	}
+
+	return previews, nil
+}
+
+func applyBatchOperation(
+	group *domain.AssetsGroup, op *boundaries.BatchOperation, r *boundaries.BatchOperationResult) error {
+	if op.Op == boundaries.BATCH_OPERATION_ADD {
+		if op.Label == nil {
+			return domain.ErrInvalidBatchOperation
+		}
+		a := addAsset(group, &boundaries.CreateAssetForGroupInput{
+			GroupId:       group.Id,
+			Label:         *op.Label,
+			Ticker:        valueOf(op.Ticker),
+			Quantity:      valueOf(op.Quantity),
+			Score:         valueOf(op.Score),
+			PreviousValue: valueOf(op.PreviousValue),
+			CurrentValue:  valueOf(op.CurrentValue),
+			Include:       valueOf(op.Include),
+		})
+		r.AssetId = a.Id
+		return nil
+	}
+
+	idx := slices.IndexFunc(group.Assets, func(a *domain.Asset) bool {
+		return a.Id == op.AssetId
+	})
+	if idx < 0 {
+		return domain.ErrAssetNotFound
+	}
+
+	a := group.Assets[idx]
+	before := *a
+	switch op.Op {
+	case boundaries.BATCH_OPERATION_REMOVE:
+		group.TrashAssetAt(idx, time.Now())
+	case boundaries.BATCH_OPERATION_INCLUDE:
+		if op.Include == nil {
+			return domain.ErrInvalidBatchOperation
+		}
+		a.Include = *op.Include
+		group.RecordAssetChanges(domain.AUDIT_UPDATE_ASSET, before, a)
+	default:
+		if err := updateAsset(a, &boundaries.UpdateAssetInput{
+			Label:         op.Label,
+			Ticker:        op.Ticker,
+			Quantity:      op.Quantity,
+			Score:         op.Score,
+			PreviousValue: op.PreviousValue,
+			CurrentValue:  op.CurrentValue,
+			Include:       op.Include,
+		}); err != nil {
+			return err
+		}
+		group.RecordAssetChanges(domain.AUDIT_UPDATE_ASSET, before, a)
+	}
+
 	return nil
 }
-func removeAsset(assets []*domain.Asset, idx int) []*domain.Asset {
-	return append(assets[:idx], assets[idx+1:]...)
+
+// replace saves the group along with the changes recorded on it.
+func (abs *AssetsBalancerService) replace(ctx context.Context, assetsGroup *domain.AssetsGroup) error {
+	return abs.journal.Save(ctx, assetsGroup, func(ctx context.Context) error {
+		return replaceGroup(ctx, abs.repository, assetsGroup)
+	})
 }
 
-func updateAsset(a *domain.Asset, input *boundaries.UpdateAssetInput) {
-	a.CurrentValue = input.CurrentValue
-	a.PreviousValue = input.PreviousValue
-	a.Score = input.Score
-	a.Include = input.Include
-	if input.Label != "" {
-		a.Label = input.Label
+// recordGroupCreated records the events and audit entries of a new
+// group and each of its assets.
+func recordGroupCreated(group *domain.AssetsGroup) {
+	group.Record(domain.AssetsGroupCreated{
+		Label:             group.Label,
+		ContributionTotal: group.ContributionTotal,
+		Assets:            len(group.Assets),
+	})
+	group.RecordAudit(domain.AUDIT_CREATE_ASSETS_GROUP, uuid.Nil,
+		domain.DiffAssetsGroups(nil, group))
+	for _, a := range group.Assets {
+		recordAssetAdded(group, domain.AUDIT_CREATE_ASSETS_GROUP, a)
 	}
 }
 
-func balance(group *domain.AssetsGroup) {
+func recordAssetAdded(group *domain.AssetsGroup, operation string, a *domain.Asset) {
+	group.Record(domain.AssetAdded{
+		AssetId:      a.Id,
+		Label:        a.Label,
+		Score:        a.Score,
+		CurrentValue: a.CurrentValue,
+	})
+	group.RecordAudit(operation, a.Id, domain.DiffAssets(nil, a))
+}
+
+func addAsset(group *domain.AssetsGroup, input *boundaries.CreateAssetForGroupInput) *domain.Asset {
+	total := group.CurrentTotal() + input.CurrentValue
+	a := domain.NewAsset(input.Label,
+		input.Score, input.PreviousValue, input.CurrentValue,
+		total, group.ContributionTotal, input.Include)
+	a.Ticker = input.Ticker
+	a.Quantity = input.Quantity
+
+	group.Assets = append(group.Assets, a)
+	recordAssetAdded(group, domain.AUDIT_CREATE_ASSET, a)
+
+	return a
+}
+
+// updateAsset sets the fields given in input. The score of an asset
+// following a glide path can't be set other than by the glide path.
+func updateAsset(a *domain.Asset, input *boundaries.UpdateAssetInput) error {
+	if input.Score != nil && *input.Score != a.Score && a.GlidePath != nil {
+		return domain.ErrScoreFollowsGlidePath
+	}
+	if input.Label != nil {
+		a.Label = *input.Label
+	}
+	if input.Ticker != nil {
+		a.Ticker = *input.Ticker
+	}
+	if input.Quantity != nil {
+		if err := a.SetQuantity(*input.Quantity); err != nil {
+			return err
+		}
+	}
+	if input.Score != nil {
+		a.Score = *input.Score
+	}
+	if input.PreviousValue != nil {
+		a.PreviousValue = *input.PreviousValue
+	}
+	if input.CurrentValue != nil {
+		a.CurrentValue = *input.CurrentValue
+	}
+	if input.Include != nil {
+		a.Include = *input.Include
+	}
+
+	return nil
+}
+
+// newGroupLimits reads "limits.maxAssetsPerGroup" and
+// "limits.maxGroupsPerOwner".
+func newGroupLimits(cfg *viper.Viper) *groupLimits {
+	return &groupLimits{
+		maxAssetsPerGroup: cfg.GetInt("limits.maxAssetsPerGroup"),
+		maxGroupsPerOwner: cfg.GetInt("limits.maxGroupsPerOwner"),
+	}
+}
+
+// checkGroups tells whether owner may have one more group in use.
+// Groups in the trash don't count. It runs in the transaction that adds
+// the group, so the count and the write go together.
+func (l *groupLimits) checkGroups(
+	ctx context.Context, repository ports.Repository[*domain.AssetsGroup], owner string) error {
+	if l.maxGroupsPerOwner > 0 && repository.Count(ctx, notDeleted(map[string]interface{}{
+		"owner": owner,
+	})) >= int64(l.maxGroupsPerOwner) {
+		return domain.ErrTooManyAssetsGroups
+	}
+	return nil
+}
+
+func (l *groupLimits) checkAssets(count int) error {
+	if l.maxAssetsPerGroup > 0 && count > l.maxAssetsPerGroup {
+		return domain.ErrTooManyAssets
+	}
+	return nil
+}
+
+// balance works out the contributions of the group against the targets
+// in effect now, moving the scores along their glide paths first, within
+// the constraints of the assets and in the rebalance mode of the group,
+// then estimates the gains its sales would realize.
+func balance(ctx context.Context, group *domain.AssetsGroup) {
+	now := time.Now()
+	defer observeRebalance(now)
+	_, span := startSpan(ctx, "rebalance", groupAttributes(group)...)
+	defer span.End()
+	group.FollowGlidePaths(now)
 	for _, a := range group.Assets {
 		if a.Include {
 			a.PercentageFromTotal = a.CalculatePercentageFromTotal(group.CurrentTotal())
 			a.ValueVariation = a.CalculateValueVariation()
-			a.FinalContribution = a.CalculateFinalContribution(group.ContributionTotal, group.CurrentTotal())
 		}
 	}
+	group.Rebalance()
+	if o := group.Optimization; o != nil && o.Warning != "" {
+		slog.WarnCtx(ctx, "optimization stopped short", "group_id", group.Id, "warning", o.Warning)
+	}
+	group.EstimateSales(now)
+}
+
+// replaceGroup saves the group over the version it was read at, and
+// fails when it has been saved since, rather than overwrite that save.
+// The group keeps the version it was read at unless it was saved, even
+// when the repository panics, so a retried transaction saves it over
+// that version again.
+func replaceGroup(
+	ctx context.Context, repository ports.Repository[*domain.AssetsGroup], group *domain.AssetsGroup) error {
+	current := group.Version
+	var version interface{} = current
+	if current == 0 {
+		// groups saved before they were versioned have no version yet
+		version = map[string]interface{}{
+			"$in": []interface{}{0, nil},
+		}
+	}
+	saved := false
+	defer func() {
+		if !saved {
+			group.Version = current
+		}
+	}()
+	// the group is saved as it is, so it carries the next version
+	group.Version = current + 1
+	saved = repository.Replace(ctx, map[string]interface{}{
+		"id":      group.Id,
+		"version": version,
+	}, group)
+	if !saved {
+		return domain.ErrAssetsGroupConflict
+	}
+	return nil
+}
+
+// notDeleted narrows a group filter to the groups that aren't in the
+// trash.
+func notDeleted(filter map[string]interface{}) map[string]interface{} {
+	filter["deletedat"] = nil
+	return filter
+}
+
+// valueOf reads an optional input field, defaulting to the zero value.
+func valueOf[T any](p *T) T {
+	var v T
+	if p != nil {
+		v = *p
+	}
+	return v
 }
@@ -3,10 +3,13 @@ package adapters
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/romaopatrick/assets-balancer/internal/boundaries"
 	"github.com/romaopatrick/assets-balancer/internal/domain"
 
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,9 +19,19 @@ type (
 		mockInsert     func(e T)
 		mockGetAll     func(filter map[string]interface{}) []T
 		mockGetFirst   func(filter map[string]interface{}) T
-		mockReplace    func(filter map[string]interface{}, entity T)
-		mockDeleteAll  func(filter map[string]interface{})
+		mockCount      func(filter map[string]interface{}) int64
+		// mockCountElements is handed the filter and the array field.
+		mockCountElements func(filter map[string]interface{}, field string) int64
+		mockReplace       func(filter map[string]interface{}, entity T)
+		// mockMatches tells whether a replace finds its document, which
+		// it always does when nil.
+		mockMatches   func(filter map[string]interface{}) bool
+		mockDeleteAll func(filter map[string]interface{}) int64
 	}
+	mockedNotificationPublisher struct {
+		published []*domain.Notification
+	}
+	mockedTransactor struct{}
 )
 
 func Test_Should_CreateAssetsGroup(t *testing.T) {
@@ -28,7 +41,7 @@ func Test_Should_CreateAssetsGroup(t *testing.T) {
 	r.mockInsert = func(e *domain.AssetsGroup) {
 		r.mockedDatabase = append(r.mockedDatabase, e)
 	}
-	s := NewAssetsBalancerUseCase(r)
+	s := NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, newMockedChangeJournal())
 	input := Input_Test_Should_CreateAssetsGroup()
 	res, err := s.CreateAssetsGroup(context.Background(), input)
 	if !assert.Nil(err) ||
@@ -55,18 +68,96 @@ func Test_Should_UpdateAsset(t *testing.T) {
 		assetsGroup = entity
 	}
 
-	s := NewAssetsBalancerUseCase(r)
+	s := NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, newMockedChangeJournal())
 	input := &boundaries.UpdateAssetInput{
 		Id:           targetAsset.Id,
 		GroupId:      assetsGroup.Id,
-		Label:        "testTargetUpdated",
-		CurrentValue: 306,
-		Include:      true,
+		Label:        ptr("testTargetUpdated"),
+		CurrentValue: ptr(306.0),
 	}
 	res, err := s.UpdateAsset(context.Background(), input)
 	if !assert.Nil(err) ||
 		!assert.EqualValues(res, assetsGroup) ||
-		!assert.EqualValues(targetAsset.CurrentValue, input.CurrentValue) {
+		!assert.EqualValues(targetAsset.CurrentValue, *input.CurrentValue) ||
+		!assert.EqualValues(35, targetAsset.Score) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_KeepAssetFieldsLeftOutOfUpdate(t *testing.T) {
+	assert := assert.New(t)
+	targetAsset := domain.NewAsset("testTarget", 35, 300, 303, 394, 100, true)
+	targetAsset.Ticker = "ABC"
+	targetAsset.Quantity = 3
+	before := *targetAsset
+	assetsGroup := domain.NewAssetGroup("test", []*domain.Asset{targetAsset}, 100)
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		return assetsGroup
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {}
+
+	s := NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, newMockedChangeJournal())
+	_, err := s.UpdateAsset(context.Background(), &boundaries.UpdateAssetInput{
+		Id:      targetAsset.Id,
+		GroupId: assetsGroup.Id,
+		Label:   ptr("renamed"),
+	})
+	if !assert.Nil(err) ||
+		!assert.Equal("renamed", targetAsset.Label) ||
+		!assert.Equal(before.Ticker, targetAsset.Ticker) ||
+		!assert.Equal(before.Quantity, targetAsset.Quantity) ||
+		!assert.Equal(before.Score, targetAsset.Score) ||
+		!assert.Equal(before.PreviousValue, targetAsset.PreviousValue) ||
+		!assert.Equal(before.CurrentValue, targetAsset.CurrentValue) ||
+		!assert.Equal(before.Include, targetAsset.Include) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_Not_UpdateAssetQuantityBelowItsLots(t *testing.T) {
+	assert := assert.New(t)
+	targetAsset := domain.NewAsset("testTarget", 100, 300, 300, 300, 0, true)
+	targetAsset.Quantity = 10
+	lot, _ := domain.NewTaxLot(10, 250, time.Now())
+	targetAsset.AddTaxLot(lot)
+	assetsGroup := domain.NewAssetGroup("test", []*domain.Asset{targetAsset}, 0)
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		return assetsGroup
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {
+		t.Fatal("the quantity below the lots was saved")
+	}
+
+	s := NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, newMockedChangeJournal())
+	_, err := s.UpdateAsset(context.Background(), &boundaries.UpdateAssetInput{
+		Id:       targetAsset.Id,
+		GroupId:  assetsGroup.Id,
+		Quantity: ptr(8.0),
+	})
+
+	assert.ErrorIs(err, domain.ErrInvalidTaxLot)
+	assert.EqualValues(10, targetAsset.Quantity)
+}
+
+func Test_Should_SetContributionTotalToZero(t *testing.T) {
+	assert := assert.New(t)
+	assetsGroup := domain.NewAssetGroup("test", []*domain.Asset{}, 100)
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		return assetsGroup
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {}
+
+	s := NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, newMockedChangeJournal())
+	res, err := s.UpdateAssetsGroup(context.Background(), &boundaries.UpdateAssetsGroup{
+		Id:                assetsGroup.Id,
+		ContributionTotal: ptr(0.0),
+	})
+	if !assert.Nil(err) ||
+		!assert.Zero(res.ContributionTotal) ||
+		!assert.Equal("test", res.Label) {
 		t.FailNow()
 	}
 }
@@ -86,7 +177,7 @@ func Test_Should_DeleteAsset(t *testing.T) {
 		assetsGroup = entity
 	}
 
-	s := NewAssetsBalancerUseCase(r)
+	s := NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, newMockedChangeJournal())
 	input := &boundaries.DeleteAssetInput{
 		Id:      targetAsset.Id,
 		GroupId: assetsGroup.Id,
@@ -99,6 +190,80 @@ func Test_Should_DeleteAsset(t *testing.T) {
 	}
 }
 
+func Test_Should_ApplyBatchInOneSave(t *testing.T) {
+	assert := assert.New(t)
+	updated := domain.NewAsset("updated", 50, 100, 100, 300, 100, true)
+	removed := domain.NewAsset("removed", 20, 100, 100, 300, 100, true)
+	excluded := domain.NewAsset("excluded", 30, 100, 100, 300, 100, true)
+	assetsGroup := domain.NewAssetGroup("test", []*domain.Asset{updated, removed, excluded}, 100)
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		return assetsGroup
+	}
+	saves := 0
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {
+		saves++
+		assetsGroup = entity
+	}
+
+	s := NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, newMockedChangeJournal())
+	res, err := s.ApplyBatch(context.Background(), &boundaries.BatchAssetsInput{
+		GroupId: assetsGroup.Id,
+		Operations: []*boundaries.BatchOperation{
+			{Op: boundaries.BATCH_OPERATION_UPDATE, AssetId: updated.Id, CurrentValue: ptr(150.0)},
+			{Op: boundaries.BATCH_OPERATION_REMOVE, AssetId: removed.Id},
+			{Op: boundaries.BATCH_OPERATION_INCLUDE, AssetId: excluded.Id, Include: ptr(false)},
+			{Op: boundaries.BATCH_OPERATION_ADD, Label: ptr("added"), Score: ptr[float32](20), Include: ptr(true)},
+		},
+	})
+	if !assert.Nil(err) ||
+		!assert.True(res.Applied) ||
+		!assert.Len(res.Results, 4) ||
+		!assert.Equal(1, saves) ||
+		!assert.Len(assetsGroup.Assets, 3) {
+		t.FailNow()
+	}
+	for _, r := range res.Results {
+		assert.Empty(r.Error)
+	}
+	assert.EqualValues(150, assetsGroup.Assets[0].CurrentValue)
+	assert.False(assetsGroup.Assets[1].Include)
+	assert.Equal(res.Results[3].AssetId, assetsGroup.Assets[2].Id)
+}
+
+func Test_Should_Not_ApplyBatchWithFailedOperation(t *testing.T) {
+	assert := assert.New(t)
+	target := domain.NewAsset("target", 100, 100, 100, 100, 100, true)
+	assetsGroup := domain.NewAssetGroup("test", []*domain.Asset{target}, 100)
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		return assetsGroup
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {
+		t.Fatal("a failed batch must not be saved")
+	}
+
+	s := NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, newMockedChangeJournal())
+	res, err := s.ApplyBatch(context.Background(), &boundaries.BatchAssetsInput{
+		GroupId: assetsGroup.Id,
+		Operations: []*boundaries.BatchOperation{
+			{Op: boundaries.BATCH_OPERATION_UPDATE, AssetId: target.Id, CurrentValue: ptr(150.0)},
+			{Op: boundaries.BATCH_OPERATION_REMOVE, AssetId: uuid.New()},
+			{Op: boundaries.BATCH_OPERATION_ADD},
+		},
+	})
+	if !assert.Nil(err) ||
+		!assert.False(res.Applied) ||
+		!assert.Nil(res.Group) ||
+		!assert.Len(res.Results, 3) {
+		t.FailNow()
+	}
+	assert.Empty(res.Results[0].Error)
+	assert.Equal(domain.ASSET_NOT_FOUND, res.Results[1].Error)
+	assert.Equal(domain.INVALID_BATCH_OPERATION, res.Results[2].Error)
+	assert.EqualValues(100, target.CurrentValue)
+}
+
 func Test_Should_DeleteAssetsGroup(t *testing.T) {
 	assert := assert.New(t)
 	assets := []*domain.Asset{}
@@ -107,10 +272,12 @@ func Test_Should_DeleteAssetsGroup(t *testing.T) {
 	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
 		return assetsGroup
 	}
-	r.mockDeleteAll = func(filter map[string]interface{}) {
+	var replaced *domain.AssetsGroup
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {
+		replaced = entity
 	}
 
-	s := NewAssetsBalancerUseCase(r)
+	s := NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, newMockedChangeJournal())
 	input := &boundaries.DeleteAssetsGroupInput{
 		Id: assetsGroup.Id,
 	}
@@ -118,6 +285,136 @@ func Test_Should_DeleteAssetsGroup(t *testing.T) {
 	if !assert.Nil(err) {
 		t.FailNow()
 	}
+	if !assert.NotNil(replaced) {
+		t.FailNow()
+	}
+	assert.NotNil(replaced.DeletedAt)
+}
+
+func Test_Should_RestoreAssetsGroupsAndAssetsFromTheTrash(t *testing.T) {
+	assert := assert.New(t)
+	cfg := viper.New()
+	cfg.Set("limits.maxGroupsPerOwner", 1)
+	kept := domain.NewAsset("RF", 50, 0, 50, 100, 0, true)
+	removed := domain.NewAsset("RV", 50, 0, 50, 100, 0, true)
+	assetsGroup := domain.NewAssetGroup("test", []*domain.Asset{kept, removed}, 100)
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockedDatabase = append(r.mockedDatabase, assetsGroup)
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		if (filter["deletedat"] == nil) == assetsGroup.IsDeleted() {
+			return nil
+		}
+		return assetsGroup
+	}
+	r.mockCount = func(filter map[string]interface{}) int64 {
+		// the only group is the one being restored from the trash
+		return 0
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {
+		assetsGroup = entity
+	}
+	s := NewAssetsBalancerUseCase(cfg, r, &mockedNotificationPublisher{}, newMockedChangeJournal())
+	ctx := context.Background()
+
+	_, err := s.DeleteAsset(ctx, &boundaries.DeleteAssetInput{GroupId: assetsGroup.Id, Id: removed.Id})
+	if !assert.Nil(err) {
+		t.FailNow()
+	}
+	trash, err := s.GetDeletedAssets(ctx, &boundaries.GetAssetsGroupInput{Id: assetsGroup.Id})
+	assert.Nil(err)
+	assert.Equal([]*domain.Asset{removed}, trash)
+	assert.NotNil(removed.DeletedAt)
+	assert.Len(assetsGroup.Assets, 1)
+	assert.Equal(1., kept.PercentageFromTotal)
+
+	group, err := s.RestoreAsset(ctx, &boundaries.RestoreAssetInput{GroupId: assetsGroup.Id, Id: removed.Id})
+	if !assert.Nil(err) {
+		t.FailNow()
+	}
+	assert.Len(group.Assets, 2)
+	assert.Empty(group.DeletedAssets)
+	assert.Nil(removed.DeletedAt)
+	assert.Equal(.5, kept.PercentageFromTotal)
+	_, err = s.RestoreAsset(ctx, &boundaries.RestoreAssetInput{GroupId: assetsGroup.Id, Id: removed.Id})
+	assert.EqualError(err, domain.ASSET_NOT_FOUND)
+
+	assert.Nil(s.DeleteAssetsGroup(ctx, &boundaries.DeleteAssetsGroupInput{Id: assetsGroup.Id}))
+	assert.Nil(s.GetAssetsGroup(ctx, &boundaries.GetAssetsGroupInput{Id: assetsGroup.Id}))
+	_, err = s.RestoreAssetsGroup(ctx, &boundaries.RestoreAssetsGroupInput{Id: assetsGroup.Id})
+	if !assert.Nil(err) {
+		t.FailNow()
+	}
+	assert.False(assetsGroup.IsDeleted())
+
+	_, err = s.RestoreAssetsGroup(ctx, &boundaries.RestoreAssetsGroupInput{Id: assetsGroup.Id})
+	assert.EqualError(err, domain.ASSETS_GROUP_NOT_FOUND)
+}
+
+func Test_Should_Not_GrowGroupsPastTheLimits(t *testing.T) {
+	assert := assert.New(t)
+	cfg := viper.New()
+	cfg.Set("limits.maxAssetsPerGroup", 3)
+	cfg.Set("limits.maxGroupsPerOwner", 1)
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockInsert = func(e *domain.AssetsGroup) {
+		r.mockedDatabase = append(r.mockedDatabase, e)
+	}
+	r.mockCount = func(filter map[string]interface{}) int64 {
+		count := int64(0)
+		for _, g := range r.mockedDatabase {
+			if g.Owner == filter["owner"] {
+				count++
+			}
+		}
+		return count
+	}
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		return r.mockedDatabase[0]
+	}
+	s := NewAssetsBalancerUseCase(cfg, r, &mockedNotificationPublisher{}, newMockedChangeJournal())
+	ctx := domain.WithActor(domain.WithPrincipal(context.Background(), "alice"), "bob")
+
+	group, err := s.CreateAssetsGroup(ctx, Input_Test_Should_CreateAssetsGroup())
+	if !assert.Nil(err) || !assert.Equal("alice", group.Owner) {
+		t.FailNow()
+	}
+	_, tooManyAssets := s.CreateAsset(ctx, &boundaries.CreateAssetForGroupInput{
+		GroupId: group.Id,
+		Label:   "Cripto",
+	})
+	_, tooManyGroups := s.CreateAssetsGroup(ctx, &boundaries.CreateAssetsGroupInput{Label: "second"})
+	_, sameActor := s.CreateAssetsGroup(domain.WithActor(context.Background(), "bob"),
+		&boundaries.CreateAssetsGroupInput{Label: "second"})
+
+	assert.EqualError(tooManyAssets, domain.TOO_MANY_ASSETS)
+	assert.EqualError(tooManyGroups, domain.TOO_MANY_ASSETS_GROUPS)
+	assert.Nil(sameActor)
+}
+
+func Test_Should_KeepTheVersionOfGroupsThatWerentSaved(t *testing.T) {
+	assert := assert.New(t)
+	group := domain.NewAssetGroup("test", []*domain.Asset{}, 0)
+	group.Version = 3
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockMatches = func(filter map[string]interface{}) bool {
+		return filter["version"] == int64(3)
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {
+		assert.EqualValues(4, entity.Version)
+		panic("connection lost")
+	}
+
+	assert.Panics(func() { replaceGroup(context.Background(), r, group) })
+	assert.EqualValues(3, group.Version)
+
+	r.mockMatches = func(filter map[string]interface{}) bool { return false }
+	assert.ErrorIs(replaceGroup(context.Background(), r, group), domain.ErrAssetsGroupConflict)
+	assert.EqualValues(3, group.Version)
+
+	r.mockMatches = nil
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {}
+	assert.Nil(replaceGroup(context.Background(), r, group))
+	assert.EqualValues(4, group.Version)
 }
 
 func Input_Test_Should_Not_CreateAssetsGroupWithInvalidInput() *boundaries.CreateAssetsGroupInput {
@@ -189,9 +486,46 @@ func (mr *mockedRepository[T]) GetAll(ctx context.Context, filter map[string]int
 func (mr *mockedRepository[T]) GetFirst(ctx context.Context, filter map[string]interface{}) T {
 	return mr.mockGetFirst(filter)
 }
-func (mr *mockedRepository[T]) Replace(ctx context.Context, filter map[string]interface{}, entity T) {
+func (mr *mockedRepository[T]) Count(ctx context.Context, filter map[string]interface{}) int64 {
+	return mr.mockCount(filter)
+}
+func (mr *mockedRepository[T]) CountElements(ctx context.Context, filter map[string]interface{}, field string) int64 {
+	return mr.mockCountElements(filter, field)
+}
+func (mr *mockedRepository[T]) Replace(ctx context.Context, filter map[string]interface{}, entity T) bool {
+	if mr.mockMatches != nil && !mr.mockMatches(filter) {
+		return false
+	}
 	mr.mockReplace(filter, entity)
+	return true
+}
+func (mr *mockedRepository[T]) DeleteAll(ctx context.Context, filter map[string]interface{}) int64 {
+	return mr.mockDeleteAll(filter)
+}
+
+func newMockedInsertingRepository[T interface{}]() *mockedRepository[T] {
+	r := newMockedRepository[T]()
+	r.mockInsert = func(e T) {
+		r.mockedDatabase = append(r.mockedDatabase, e)
+	}
+	return r
+}
+
+func newMockedChangeJournal() *ChangeJournal {
+	return NewChangeJournal(&mockedTransactor{},
+		newMockedInsertingRepository[*domain.DomainEvent](),
+		newMockedInsertingRepository[*domain.AuditEntry](),
+		NewInProcessGroupUpdateBroker())
+}
+
+func (mt *mockedTransactor) WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error {
+	return fn(ctx)
+}
+
+func (mp *mockedNotificationPublisher) Publish(ctx context.Context, n *domain.Notification) {
+	mp.published = append(mp.published, n)
 }
-func (mr *mockedRepository[T]) DeleteAll(ctx context.Context, filter map[string]interface{}) {
-	mr.mockDeleteAll(filter)
+
+func ptr[T any](v T) *T {
+	return &v
 }
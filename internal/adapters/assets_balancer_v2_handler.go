@@ -0,0 +1,591 @@
+package adapters
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+)
+
+type (
+	// AssetsBalancerV2Handler serves the groups and their assets as REST
+	// resources, taking the ids from the path instead of the body.
+	AssetsBalancerV2Handler struct {
+		useCase ports.AssetBalancerUseCase
+	}
+)
+
+func (h *AssetsBalancerV2Handler) HandleGetGroups(c *gin.Context) {
+	res := h.useCase.GetAssetsGroups(c)
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *AssetsBalancerV2Handler) HandleGetGroup(c *gin.Context) {
+	group, ok := h.findGroup(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, group)
+}
+
+func (h *AssetsBalancerV2Handler) HandleCreateGroup(c *gin.Context) {
+	input := &boundaries.CreateAssetsGroupInput{}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
+		return
+	}
+
+	res, err := h.useCase.CreateAssetsGroup(c, input)
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.Header("Location", groupLocation(res.Id))
+	c.JSON(http.StatusCreated, res)
+}
+
+func (h *AssetsBalancerV2Handler) HandleUpdateGroup(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+	input := &boundaries.UpdateAssetsGroup{}
+
+	if !bindJSONWithPath(c, input, func() {
+		input.Id = groupId
+	}) {
+		return
+	}
+
+	res, err := h.useCase.UpdateAssetsGroup(c, input)
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *AssetsBalancerV2Handler) HandleDeleteGroup(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+
+	err := h.useCase.DeleteAssetsGroup(c, &boundaries.DeleteAssetsGroupInput{
+		Id: groupId,
+	})
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *AssetsBalancerV2Handler) HandleGetAssets(c *gin.Context) {
+	group, ok := h.findGroup(c)
+	if !ok {
+		return
+	}
+
+	c.JSON(http.StatusOK, group.Assets)
+}
+
+func (h *AssetsBalancerV2Handler) HandleGetAsset(c *gin.Context) {
+	group, ok := h.findGroup(c)
+	if !ok {
+		return
+	}
+	assetId, ok := pathId(c, "assetId")
+	if !ok {
+		return
+	}
+
+	a := findAsset(group, assetId)
+	if a == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, newErrorResult(domain.ASSET_NOT_FOUND))
+		return
+	}
+
+	c.JSON(http.StatusOK, a)
+}
+
+func (h *AssetsBalancerV2Handler) HandleCreateAsset(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+	input := &boundaries.CreateAssetForGroupInput{}
+
+	if !bindJSONWithPath(c, input, func() {
+		input.GroupId = groupId
+	}) {
+		return
+	}
+
+	res, err := h.useCase.CreateAsset(c, input)
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	// the new asset is appended last to the group
+	a := res.Assets[len(res.Assets)-1]
+	c.Header("Location", assetLocation(groupId, a.Id))
+	c.JSON(http.StatusCreated, a)
+}
+
+func (h *AssetsBalancerV2Handler) HandleUpdateAsset(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+	assetId, ok := pathId(c, "assetId")
+	if !ok {
+		return
+	}
+	input := &boundaries.UpdateAssetInput{}
+
+	if !bindJSONWithPath(c, input, func() {
+		input.GroupId = groupId
+		input.Id = assetId
+	}) {
+		return
+	}
+
+	res, err := h.useCase.UpdateAsset(c, input)
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, findAsset(res, assetId))
+}
+
+func (h *AssetsBalancerV2Handler) HandleDeleteAsset(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+	assetId, ok := pathId(c, "assetId")
+	if !ok {
+		return
+	}
+
+	_, err := h.useCase.DeleteAsset(c, &boundaries.DeleteAssetInput{
+		Id:      assetId,
+		GroupId: groupId,
+	})
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *AssetsBalancerV2Handler) HandleCloneGroup(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+	input := &boundaries.CloneAssetsGroupInput{}
+
+	if !bindJSONWithPath(c, input, func() {
+		input.Id = groupId
+	}) {
+		return
+	}
+
+	res, err := h.useCase.CloneAssetsGroup(c, input)
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.Header("Location", groupLocation(res.Id))
+	c.JSON(http.StatusCreated, res)
+}
+
+func (h *AssetsBalancerV2Handler) HandleGetDeletedGroups(c *gin.Context) {
+	res := h.useCase.GetDeletedAssetsGroups(c)
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *AssetsBalancerV2Handler) HandleRestoreGroup(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+
+	res, err := h.useCase.RestoreAssetsGroup(c, &boundaries.RestoreAssetsGroupInput{
+		Id: groupId,
+	})
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *AssetsBalancerV2Handler) HandleGetDeletedAssets(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+
+	res, err := h.useCase.GetDeletedAssets(c, &boundaries.GetAssetsGroupInput{
+		Id: groupId,
+	})
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *AssetsBalancerV2Handler) HandleRestoreAsset(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+	assetId, ok := pathId(c, "assetId")
+	if !ok {
+		return
+	}
+
+	res, err := h.useCase.RestoreAsset(c, &boundaries.RestoreAssetInput{
+		Id:      assetId,
+		GroupId: groupId,
+	})
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, findAsset(res, assetId))
+}
+
+func (h *AssetsBalancerV2Handler) HandleSetGlidePath(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+	assetId, ok := pathId(c, "assetId")
+	if !ok {
+		return
+	}
+	input := &boundaries.SetGlidePathInput{}
+
+	if !bindJSONWithPath(c, input, func() {
+		input.GroupId = groupId
+		input.Id = assetId
+	}) {
+		return
+	}
+
+	res, err := h.useCase.SetGlidePath(c, input)
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, findAsset(res, assetId))
+}
+
+func (h *AssetsBalancerV2Handler) HandleDeleteGlidePath(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+	assetId, ok := pathId(c, "assetId")
+	if !ok {
+		return
+	}
+
+	res, err := h.useCase.DeleteGlidePath(c, &boundaries.DeleteGlidePathInput{
+		Id:      assetId,
+		GroupId: groupId,
+	})
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, findAsset(res, assetId))
+}
+
+func (h *AssetsBalancerV2Handler) HandleSetAssetConstraints(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+	assetId, ok := pathId(c, "assetId")
+	if !ok {
+		return
+	}
+	input := &boundaries.SetAssetConstraintsInput{}
+
+	if !bindJSONWithPath(c, input, func() {
+		input.GroupId = groupId
+		input.Id = assetId
+	}) {
+		return
+	}
+
+	res, err := h.useCase.SetAssetConstraints(c, input)
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, findAsset(res, assetId))
+}
+
+func (h *AssetsBalancerV2Handler) HandleDeleteAssetConstraints(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+	assetId, ok := pathId(c, "assetId")
+	if !ok {
+		return
+	}
+
+	res, err := h.useCase.DeleteAssetConstraints(c, &boundaries.DeleteAssetConstraintsInput{
+		Id:      assetId,
+		GroupId: groupId,
+	})
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, findAsset(res, assetId))
+}
+
+func (h *AssetsBalancerV2Handler) HandleSetTradingCosts(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+	assetId, ok := pathId(c, "assetId")
+	if !ok {
+		return
+	}
+	input := &boundaries.SetTradingCostsInput{}
+
+	if !bindJSONWithPath(c, input, func() {
+		input.GroupId = groupId
+		input.Id = assetId
+	}) {
+		return
+	}
+
+	res, err := h.useCase.SetTradingCosts(c, input)
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, findAsset(res, assetId))
+}
+
+func (h *AssetsBalancerV2Handler) HandleDeleteTradingCosts(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+	assetId, ok := pathId(c, "assetId")
+	if !ok {
+		return
+	}
+
+	res, err := h.useCase.DeleteTradingCosts(c, &boundaries.DeleteTradingCostsInput{
+		Id:      assetId,
+		GroupId: groupId,
+	})
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, findAsset(res, assetId))
+}
+
+func (h *AssetsBalancerV2Handler) HandleAddTaxLot(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+	assetId, ok := pathId(c, "assetId")
+	if !ok {
+		return
+	}
+	input := &boundaries.AddTaxLotInput{}
+
+	if !bindJSONWithPath(c, input, func() {
+		input.GroupId = groupId
+		input.AssetId = assetId
+	}) {
+		return
+	}
+
+	res, err := h.useCase.AddTaxLot(c, input)
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	// the new lot is appended last to the asset
+	a := findAsset(res, assetId)
+	lot := a.TaxLots[len(a.TaxLots)-1]
+	c.Header("Location", assetLocation(groupId, assetId)+"/lots/"+lot.Id.String())
+	c.JSON(http.StatusCreated, lot)
+}
+
+func (h *AssetsBalancerV2Handler) HandleDeleteTaxLot(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+	assetId, ok := pathId(c, "assetId")
+	if !ok {
+		return
+	}
+	lotId, ok := pathId(c, "lotId")
+	if !ok {
+		return
+	}
+
+	_, err := h.useCase.DeleteTaxLot(c, &boundaries.DeleteTaxLotInput{
+		Id:      lotId,
+		AssetId: assetId,
+		GroupId: groupId,
+	})
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *AssetsBalancerV2Handler) HandlePreviewGlidePath(c *gin.Context) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return
+	}
+	input := &boundaries.PreviewGlidePathInput{}
+
+	if err := c.ShouldBindQuery(input); err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
+		return
+	}
+	input.GroupId = groupId
+
+	res, err := h.useCase.PreviewGlidePath(c, input)
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *AssetsBalancerV2Handler) findGroup(c *gin.Context) (*domain.AssetsGroup, bool) {
+	groupId, ok := pathId(c, "groupId")
+	if !ok {
+		return nil, false
+	}
+
+	group := h.useCase.GetAssetsGroup(c, &boundaries.GetAssetsGroupInput{
+		Id: groupId,
+	})
+	if group == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, newErrorResult(domain.ASSETS_GROUP_NOT_FOUND))
+		return nil, false
+	}
+
+	return group, true
+}
+
+func pathId(c *gin.Context, param string) (uuid.UUID, bool) {
+	id, err := uuid.Parse(c.Param(param))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newErrorResult(param+" "+err.Error()))
+		return uuid.Nil, false
+	}
+
+	return id, true
+}
+
+// abortWithV2Error answers 404 for missing resources, and 412 like v1
+// for anything the balancer rejects.
+func abortWithV2Error(c *gin.Context, err error) {
+	status := serviceErrorStatus(err)
+	if errors.Is(err, domain.ErrAssetsGroupNotFound) || errors.Is(err, domain.ErrAssetNotFound) ||
+		errors.Is(err, domain.ErrTemplateNotFound) || errors.Is(err, domain.ErrTaxLotNotFound) {
+		status = http.StatusNotFound
+	}
+
+	c.AbortWithStatusJSON(status, newErrorResult(err.Error()))
+}
+
+func findAsset(group *domain.AssetsGroup, assetId uuid.UUID) *domain.Asset {
+	idx := slices.IndexFunc(group.Assets, func(a *domain.Asset) bool {
+		return a.Id == assetId
+	})
+	if idx < 0 {
+		return nil
+	}
+
+	return group.Assets[idx]
+}
+
+func groupLocation(groupId uuid.UUID) string {
+	return "/v2/groups/" + groupId.String()
+}
+
+func assetLocation(groupId, assetId uuid.UUID) string {
+	return groupLocation(groupId) + "/assets/" + assetId.String()
+}
+
+func NewAssetsBalancerV2Handler(uc ports.AssetBalancerUseCase) *AssetsBalancerV2Handler {
+	return &AssetsBalancerV2Handler{
+		useCase: uc,
+	}
+}
@@ -0,0 +1,295 @@
+package adapters
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func newV2TestEngine(group *domain.AssetsGroup) *gin.Engine {
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		if filter["id"] != group.Id {
+			return nil
+		}
+		return group
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {}
+	r.mockDeleteAll = func(filter map[string]interface{}) int64 { return 0 }
+	s := NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, newMockedChangeJournal())
+
+	eng := gin.New()
+	ConfigureRouter(eng, &AssetsBalancerHandler{}, &ContributionScheduleHandler{},
+		&NotificationHandler{}, &AuditHandler{}, NewAssetsBalancerV2Handler(s), &GraphQlHandler{}, &GroupStreamHandler{}, &HealthHandler{}, &MetricsHandler{}, &IdempotencyHandler{}, &PortfolioTemplateHandler{})
+	return eng
+}
+
+func Test_Should_CreateAssetWithLocationInV2(t *testing.T) {
+	assert := assert.New(t)
+	group := domain.NewAssetGroup("test", []*domain.Asset{}, 100)
+	eng := newV2TestEngine(group)
+	body := `{"Label": "RF", "Score": 100, "CurrentValue": 50, "Include": true, "GroupId": "` + uuid.NewString() + `"}`
+
+	w := httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodPost,
+		"/v2/groups/"+group.Id.String()+"/assets", strings.NewReader(body)))
+	res := &domain.Asset{}
+
+	if !assert.Equal(http.StatusCreated, w.Code) ||
+		!assert.Nil(json.Unmarshal(w.Body.Bytes(), res)) ||
+		!assert.Len(group.Assets, 1) ||
+		!assert.Equal(group.Assets[0].Id, res.Id) ||
+		!assert.Equal("/v2/groups/"+group.Id.String()+"/assets/"+res.Id.String(),
+			w.Header().Get("Location")) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_AnswerNotFoundInV2(t *testing.T) {
+	assert := assert.New(t)
+	group := domain.NewAssetGroup("test", []*domain.Asset{}, 100)
+	eng := newV2TestEngine(group)
+
+	for _, path := range []string{
+		"/v2/groups/" + uuid.NewString(),
+		"/v2/groups/" + group.Id.String() + "/assets/" + uuid.NewString(),
+	} {
+		w := httptest.NewRecorder()
+		eng.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+		assert.Equal(http.StatusNotFound, w.Code, path)
+	}
+
+	w := httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodDelete,
+		"/v2/groups/"+group.Id.String()+"/assets/"+uuid.NewString(), nil))
+	assert.Equal(http.StatusNotFound, w.Code)
+}
+
+func Test_Should_DeleteAssetInV2(t *testing.T) {
+	assert := assert.New(t)
+	a := domain.NewAsset("RF", 100, 50, 50, 50, 100, true)
+	group := domain.NewAssetGroup("test", []*domain.Asset{a}, 100)
+	eng := newV2TestEngine(group)
+
+	w := httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodDelete,
+		"/v2/groups/"+group.Id.String()+"/assets/"+a.Id.String(), nil))
+
+	if !assert.Equal(http.StatusNoContent, w.Code) ||
+		!assert.Empty(group.Assets) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_PatchOnlyTheGivenAssetFieldsInV2(t *testing.T) {
+	assert := assert.New(t)
+	a := domain.NewAsset("RF", 40, 50, 60, 60, 100, true)
+	group := domain.NewAssetGroup("test", []*domain.Asset{a}, 100)
+	eng := newV2TestEngine(group)
+	path := "/v2/groups/" + group.Id.String() + "/assets/" + a.Id.String()
+
+	w := httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodPatch, path,
+		strings.NewReader(`{"Label": "", "Score": 120}`)))
+	res := &errorResult{}
+	if !assert.Equal(http.StatusUnprocessableEntity, w.Code) ||
+		!assert.Nil(json.Unmarshal(w.Body.Bytes(), res)) ||
+		!assert.ElementsMatch([]*fieldError{
+			{Field: "Label", Rule: "min", Message: "must have at least 1 characters"},
+			{Field: "Score", Rule: "lte", Message: "must be less than or equal to 100"},
+		}, res.Fields) {
+		t.FailNow()
+	}
+
+	w = httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodPatch, path,
+		strings.NewReader(`{"Label": "Fixed income", "Ticker": null}`)))
+	if !assert.Equal(http.StatusOK, w.Code) ||
+		!assert.Equal("Fixed income", a.Label) ||
+		!assert.EqualValues(40, a.Score) ||
+		!assert.EqualValues(50, a.PreviousValue) ||
+		!assert.EqualValues(60, a.CurrentValue) ||
+		!assert.True(a.Include) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_SetAndPreviewGlidePathsInV2(t *testing.T) {
+	assert := assert.New(t)
+	a := domain.NewAsset("Equities", 100, 0, 100, 100, 100, true)
+	bonds := domain.NewAsset("Bonds", 10, 0, 0, 100, 100, true)
+	group := domain.NewAssetGroup("retirement", []*domain.Asset{a, bonds}, 100)
+	eng := newV2TestEngine(group)
+	body := `{"Interpolation": "STEP", "Points": [
+		{"At": "2000-01-01T00:00:00Z", "Score": 90},
+		{"At": "2100-01-01T00:00:00Z", "Score": 50}]}`
+
+	w := httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodPut,
+		assetLocation(group.Id, a.Id)+"/glidePath", strings.NewReader(body)))
+
+	if !assert.Equal(http.StatusOK, w.Code, w.Body.String()) {
+		t.FailNow()
+	}
+	assert.EqualValues(90, a.Score)
+	assert.EqualValues(10, bonds.Score)
+	assert.EqualValues(80, a.FinalContribution)
+
+	w = httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodPatch,
+		assetLocation(group.Id, a.Id), strings.NewReader(`{"Score": 70}`)))
+	assert.Equal(http.StatusPreconditionFailed, w.Code)
+	assert.Contains(w.Body.String(), domain.SCORE_FOLLOWS_GLIDE_PATH)
+	assert.EqualValues(90, a.Score)
+
+	w = httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodGet,
+		groupLocation(group.Id)+"/glidePath?from=2090-01-01&stepMonths=60", nil))
+	res := []*domain.GlidePathPreview{}
+
+	if !assert.Equal(http.StatusOK, w.Code) ||
+		!assert.Nil(json.Unmarshal(w.Body.Bytes(), &res)) ||
+		!assert.Len(res, 3) {
+		t.FailNow()
+	}
+	assert.EqualValues(90, res[1].Targets[0].Score)
+	assert.EqualValues(50, res[2].Targets[0].Score)
+	assert.EqualValues(50, res[2].Targets[1].Score)
+
+	w = httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodPut,
+		assetLocation(group.Id, a.Id)+"/glidePath", strings.NewReader(`{"Points": [
+		{"At": "2100-01-01T00:00:00Z", "Score": 90},
+		{"At": "2000-01-01T00:00:00Z", "Score": 50}]}`)))
+	assert.Equal(http.StatusPreconditionFailed, w.Code)
+}
+
+func Test_Should_BalanceWithinAssetConstraintsInV2(t *testing.T) {
+	assert := assert.New(t)
+	stocks := domain.NewAsset("Stocks", 50, 0, 0, 0, 1000, true)
+	bonds := domain.NewAsset("Bonds", 50, 0, 0, 0, 1000, true)
+	group := domain.NewAssetGroup("test", []*domain.Asset{stocks, bonds}, 1000)
+	eng := newV2TestEngine(group)
+
+	w := httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodPut,
+		assetLocation(group.Id, stocks.Id)+"/constraints", strings.NewReader(`{"MaxWeight": 20}`)))
+	res := &domain.Asset{}
+
+	if !assert.Equal(http.StatusOK, w.Code, w.Body.String()) ||
+		!assert.Nil(json.Unmarshal(w.Body.Bytes(), res)) ||
+		!assert.NotNil(res.Binding) {
+		t.FailNow()
+	}
+	assert.Equal(domain.CONSTRAINT_MAX_WEIGHT, res.Binding.Constraint)
+	assert.InDelta(200, stocks.FinalContribution, 0.001)
+	assert.InDelta(800, bonds.FinalContribution, 0.001)
+
+	w = httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodDelete,
+		assetLocation(group.Id, stocks.Id)+"/constraints", nil))
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Nil(stocks.Binding)
+	assert.InDelta(500, stocks.FinalContribution, 0.001)
+}
+
+func Test_Should_OptimizeGroupsTradingWholeLotsInV2(t *testing.T) {
+	assert := assert.New(t)
+	cheap := domain.NewAsset("Cheap", 50, 0, 30, 0, 0, true)
+	cheap.Quantity = 1
+	dear := domain.NewAsset("Dear", 50, 0, 70, 0, 0, true)
+	dear.Quantity = 1
+	group := domain.NewAssetGroup("test", []*domain.Asset{cheap, dear}, 1000)
+	eng := newV2TestEngine(group)
+
+	for _, a := range group.Assets {
+		w := httptest.NewRecorder()
+		eng.ServeHTTP(w, httptest.NewRequest(http.MethodPut,
+			assetLocation(group.Id, a.Id)+"/tradingCosts", strings.NewReader(`{"LotSize": 1}`)))
+		assert.Equal(http.StatusOK, w.Code, w.Body.String())
+	}
+	w := httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodPatch,
+		groupLocation(group.Id), strings.NewReader(`{"RebalanceMode": "OPTIMIZED"}`)))
+	res := &domain.AssetsGroup{}
+
+	if !assert.Equal(http.StatusOK, w.Code, w.Body.String()) ||
+		!assert.Nil(json.Unmarshal(w.Body.Bytes(), res)) ||
+		!assert.NotNil(res.Optimization) {
+		t.FailNow()
+	}
+	assert.InDelta(510, res.Assets[0].FinalContribution, 1e-6)
+	assert.InDelta(490, res.Assets[1].FinalContribution, 1e-6)
+
+	w = httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodPatch,
+		groupLocation(group.Id), strings.NewReader(`{"RebalanceMode": "GREEDY"}`)))
+	assert.Equal(http.StatusUnprocessableEntity, w.Code)
+}
+
+func Test_Should_TrackTaxLotsAndEstimateSalesInV2(t *testing.T) {
+	assert := assert.New(t)
+	stocks := domain.NewAsset("Stocks", 0, 0, 200, 0, 0, true)
+	stocks.Quantity = 10
+	group := domain.NewAssetGroup("test", []*domain.Asset{stocks}, 0)
+	eng := newV2TestEngine(group)
+
+	w := httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodPost, assetLocation(group.Id, stocks.Id)+"/lots",
+		strings.NewReader(`{"Quantity": 10, "CostBasis": 150, "AcquiredAt": "2020-01-01T00:00:00Z"}`)))
+	lot := &domain.TaxLot{}
+
+	if !assert.Equal(http.StatusCreated, w.Code, w.Body.String()) ||
+		!assert.Nil(json.Unmarshal(w.Body.Bytes(), lot)) {
+		t.FailNow()
+	}
+	location := assetLocation(group.Id, stocks.Id) + "/lots/" + lot.Id.String()
+	assert.Equal(location, w.Header().Get("Location"))
+	if assert.NotNil(stocks.SaleEstimate) {
+		assert.InDelta(50, stocks.SaleEstimate.LongTermGain, 1e-9)
+		assert.InDelta(50, group.EstimatedRealizedGain, 1e-9)
+	}
+
+	w = httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodPatch,
+		groupLocation(group.Id), strings.NewReader(`{"TaxAware": true}`)))
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Zero(stocks.FinalContribution)
+	assert.Nil(stocks.SaleEstimate)
+
+	w = httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, location, nil))
+	assert.Equal(http.StatusNoContent, w.Code)
+	assert.Empty(stocks.TaxLots)
+
+	w = httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodDelete, location, nil))
+	assert.Equal(http.StatusNotFound, w.Code)
+}
+
+func Test_Should_AnswerWrappedServiceErrorsByTheirSentinel(t *testing.T) {
+	assert := assert.New(t)
+	for err, status := range map[error]int{
+		fmt.Errorf("group %s: %w", uuid.New(), domain.ErrAssetsGroupNotFound): http.StatusNotFound,
+		fmt.Errorf("saving: %w", domain.ErrAssetsGroupConflict):               http.StatusConflict,
+		domain.ErrInvalidScoreSum:                                             http.StatusPreconditionFailed,
+	} {
+		w := httptest.NewRecorder()
+		c, _ := gin.CreateTestContext(w)
+
+		abortWithV2Error(c, err)
+
+		assert.Equal(status, w.Code, err.Error())
+	}
+}
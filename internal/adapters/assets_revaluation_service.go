@@ -0,0 +1,112 @@
+package adapters
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/spf13/viper"
+	"golang.org/x/exp/slog"
+)
+
+type (
+	AssetsRevaluationService struct {
+		repository    ports.Repository[*domain.AssetsGroup]
+		priceProvider ports.PriceProvider
+		publisher     ports.NotificationPublisher
+		journal       *ChangeJournal
+	}
+	AssetsRevaluationJob struct {
+		useCase  ports.AssetsRevaluationUseCase
+		interval time.Duration
+	}
+)
+
+func NewAssetsRevaluationUseCase(
+	repository ports.Repository[*domain.AssetsGroup],
+	priceProvider ports.PriceProvider,
+	publisher ports.NotificationPublisher,
+	journal *ChangeJournal) ports.AssetsRevaluationUseCase {
+	return &AssetsRevaluationService{
+		repository:    repository,
+		priceProvider: priceProvider,
+		publisher:     publisher,
+		journal:       journal,
+	}
+}
+
+// RevaluateAssetsGroups prices every asset that has a ticker, rebalances
+// its group and saves it. Assets whose price can't be fetched keep their
+// current values. A group changed while it was being priced is left for
+// the next run, rather than overwriting the change, and a group the
+// prices left as it was isn't saved at all.
+func (ars *AssetsRevaluationService) RevaluateAssetsGroups(ctx context.Context) []*domain.AssetsGroup {
+	groups := ars.repository.GetAll(ctx, notDeleted(map[string]interface{}{}))
+	for _, g := range groups {
+		before := g.Clone()
+		ars.revaluate(ctx, g)
+		balance(ctx, g)
+		if reflect.DeepEqual(before, g) {
+			continue
+		}
+
+		err := ars.journal.Save(ctx, g, func(ctx context.Context) error {
+			return replaceGroup(ctx, ars.repository, g)
+		})
+		logMutation(ctx, "RevaluateAssetsGroup", err, "group_id", g.Id)
+		if err != nil {
+			continue
+		}
+		publishGroupModified(ctx, ars.publisher, g)
+	}
+
+	return groups
+}
+
+func (ars *AssetsRevaluationService) revaluate(ctx context.Context, group *domain.AssetsGroup) {
+	for _, a := range group.Assets {
+		if a.Ticker == "" {
+			continue
+		}
+		before := *a
+		price, err := ars.priceProvider.GetPrice(ctx, a.Ticker)
+		if err == nil {
+			err = a.Revalue(price)
+		}
+		if err != nil {
+			slog.WarnCtx(ctx, "asset revaluation failed", "asset_id", a.Id, "ticker", a.Ticker, "error", err)
+			continue
+		}
+		group.RecordAssetChanges(domain.AUDIT_REVALUATE_ASSET, before, a)
+	}
+}
+
+func NewAssetsRevaluationJob(
+	uc ports.AssetsRevaluationUseCase,
+	cfg *viper.Viper) *AssetsRevaluationJob {
+	return &AssetsRevaluationJob{
+		useCase:  uc,
+		interval: cfg.GetDuration("prices.revaluationInterval"),
+	}
+}
+
+// Start runs the revaluation on every interval until ctx is done.
+// A zero interval disables the job.
+func (j *AssetsRevaluationJob) Start(ctx context.Context) {
+	if j.interval <= 0 {
+		return
+	}
+	t := time.NewTicker(j.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			j.useCase.RevaluateAssetsGroups(ctx)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	mockedPriceProvider struct {
+		prices map[string]float64
+	}
+)
+
+func Test_Should_RevaluateAssetsGroups(t *testing.T) {
+	assert := assert.New(t)
+	priced := domain.NewAsset("priced", 50, 90, 100, 200, 100, true)
+	priced.Ticker = "IVVB11"
+	priced.Quantity = 2
+	manual := domain.NewAsset("manual", 50, 90, 100, 200, 100, true)
+	assetsGroup := domain.NewAssetGroup("test", []*domain.Asset{priced, manual}, 100)
+
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetAll = func(filter map[string]interface{}) []*domain.AssetsGroup {
+		return []*domain.AssetsGroup{assetsGroup}
+	}
+	replaced := 0
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {
+		replaced++
+	}
+	s := NewAssetsRevaluationUseCase(r, &mockedPriceProvider{
+		prices: map[string]float64{"IVVB11": 150},
+	}, &mockedNotificationPublisher{}, newMockedChangeJournal())
+
+	res := s.RevaluateAssetsGroups(context.Background())
+	if !assert.Len(res, 1) ||
+		!assert.Equal(1, replaced) ||
+		!assert.EqualValues(100, priced.PreviousValue) ||
+		!assert.EqualValues(300, priced.CurrentValue) ||
+		!assert.EqualValues(100, manual.CurrentValue) ||
+		!assert.EqualValues(.75, priced.PercentageFromTotal) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_LeaveGroupsChangedMeanwhileForTheNextRevaluation(t *testing.T) {
+	assert := assert.New(t)
+	priced := domain.NewAsset("priced", 100, 90, 100, 100, 0, true)
+	priced.Ticker = "IVVB11"
+	priced.Quantity = 2
+	assetsGroup := domain.NewAssetGroup("test", []*domain.Asset{priced}, 0)
+	assetsGroup.Version = 3
+
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetAll = func(filter map[string]interface{}) []*domain.AssetsGroup {
+		return []*domain.AssetsGroup{assetsGroup}
+	}
+	r.mockMatches = func(filter map[string]interface{}) bool {
+		// saved meanwhile by someone else
+		return filter["version"] == int64(4)
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {
+		t.Fatal("the newer save was overwritten")
+	}
+	outbox := newMockedInsertingRepository[*domain.DomainEvent]()
+	publisher := &mockedNotificationPublisher{}
+	s := NewAssetsRevaluationUseCase(r, &mockedPriceProvider{
+		prices: map[string]float64{"IVVB11": 150},
+	}, publisher, NewChangeJournal(&mockedTransactor{}, outbox,
+		newMockedInsertingRepository[*domain.AuditEntry](), NewInProcessGroupUpdateBroker()))
+
+	s.RevaluateAssetsGroups(context.Background())
+	assert.EqualValues(3, assetsGroup.Version)
+	assert.Empty(outbox.mockedDatabase)
+	assert.Empty(publisher.published)
+}
+
+func Test_Should_Not_SaveGroupsThePricesLeftAsTheyWere(t *testing.T) {
+	assert := assert.New(t)
+	priced := domain.NewAsset("priced", 100, 300, 300, 300, 0, true)
+	priced.Ticker = "IVVB11"
+	priced.Quantity = 2
+	assetsGroup := domain.NewAssetGroup("test", []*domain.Asset{priced}, 0)
+
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetAll = func(filter map[string]interface{}) []*domain.AssetsGroup {
+		return []*domain.AssetsGroup{assetsGroup}
+	}
+	replaced := 0
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {
+		replaced++
+	}
+	publisher := &mockedNotificationPublisher{}
+	s := NewAssetsRevaluationUseCase(r, &mockedPriceProvider{
+		prices: map[string]float64{"IVVB11": 150},
+	}, publisher, newMockedChangeJournal())
+
+	s.RevaluateAssetsGroups(context.Background())
+	saved, published := replaced, len(publisher.published)
+	s.RevaluateAssetsGroups(context.Background())
+	assert.Equal(saved, replaced)
+	assert.Len(publisher.published, published)
+}
+
+func (p *mockedPriceProvider) GetPrice(ctx context.Context, ticker string) (float64, error) {
+	price, ok := p.prices[ticker]
+	if !ok {
+		return 0, domain.ErrPriceNotFound
+	}
+	return price, nil
+}
@@ -0,0 +1,60 @@
+package adapters
+
+import (
+	"net/http"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+const actorHeader = "X-Actor"
+
+type (
+	AuditHandler struct {
+		useCase ports.AuditUseCase
+	}
+)
+
+// ActorMiddleware puts the caller named by the X-Actor header on the
+// context, where the services pick it up for the audit trail. Any caller
+// can send any name, so the header is only a label for the audit trail
+// and logs, never an identity.
+func ActorMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		actor := c.GetHeader(actorHeader)
+		if actor == "" {
+			actor = "anonymous"
+		}
+		c.Request = c.Request.WithContext(domain.WithActor(c.Request.Context(), actor))
+		c.Next()
+	}
+}
+
+func (h *AuditHandler) HandleGetAuditTrail(c *gin.Context) {
+	input := &boundaries.GetAuditTrailInput{}
+
+	if err := c.ShouldBindQuery(input); err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
+		return
+	}
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newErrorResult(err.Error()))
+		return
+	}
+	input.GroupId = id
+
+	res := h.useCase.GetAuditTrail(c, input)
+
+	c.JSON(http.StatusOK, res)
+}
+
+func NewAuditHandler(uc ports.AuditUseCase) *AuditHandler {
+	return &AuditHandler{
+		useCase: uc,
+	}
+}
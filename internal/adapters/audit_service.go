@@ -0,0 +1,67 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"golang.org/x/exp/slices"
+)
+
+type (
+	AuditService struct {
+		repository ports.Repository[*domain.AuditEntry]
+	}
+)
+
+func NewAuditUseCase(repository ports.Repository[*domain.AuditEntry]) ports.AuditUseCase {
+	return &AuditService{
+		repository: repository,
+	}
+}
+
+// GetAuditTrail lists the group audit entries, oldest first, optionally
+// narrowed to an actor and to a time window.
+func (as *AuditService) GetAuditTrail(
+	ctx context.Context, input *boundaries.GetAuditTrailInput) []*domain.AuditEntry {
+	return as.getAuditEntries(ctx, input.GroupId, input.Actor, input.From, input.To)
+}
+
+// GetAuditTrails lists the audit entries of several groups in one query,
+// oldest first, with the same narrowing as GetAuditTrail.
+func (as *AuditService) GetAuditTrails(
+	ctx context.Context, input *boundaries.GetAuditTrailsInput) []*domain.AuditEntry {
+	return as.getAuditEntries(ctx, map[string]interface{}{
+		"$in": input.GroupIds,
+	}, input.Actor, input.From, input.To)
+}
+
+func (as *AuditService) getAuditEntries(
+	ctx context.Context, groupId interface{}, actor string, from, to time.Time) []*domain.AuditEntry {
+	filter := map[string]interface{}{
+		"groupid": groupId,
+	}
+	if actor != "" {
+		filter["actor"] = actor
+	}
+	occurredAt := map[string]interface{}{}
+	if !from.IsZero() {
+		occurredAt["$gte"] = from
+	}
+	if !to.IsZero() {
+		occurredAt["$lte"] = to
+	}
+	if len(occurredAt) > 0 {
+		filter["occurredat"] = occurredAt
+	}
+
+	result := as.repository.GetAll(ctx, filter)
+	slices.SortStableFunc(result, func(a, b *domain.AuditEntry) bool {
+		return a.OccurredAt.Before(b.OccurredAt)
+	})
+
+	return result
+}
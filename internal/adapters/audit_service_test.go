@@ -0,0 +1,93 @@
+package adapters
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	mockedAuditUseCase struct {
+		input   *boundaries.GetAuditTrailInput
+		batches []*boundaries.GetAuditTrailsInput
+		entries []*domain.AuditEntry
+	}
+)
+
+func Test_Should_AuditAssetUpdateWithActorAndPrincipal(t *testing.T) {
+	assert := assert.New(t)
+	targetAsset := domain.NewAsset("test", 50, 100, 100, 100, 0, true)
+	assetsGroup := domain.NewAssetGroup("test", []*domain.Asset{targetAsset}, 0)
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		return assetsGroup
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {}
+	audit := newMockedInsertingRepository[*domain.AuditEntry]()
+	s := NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, NewChangeJournal(&mockedTransactor{},
+		newMockedInsertingRepository[*domain.DomainEvent](), audit, NewInProcessGroupUpdateBroker()))
+
+	ctx := domain.WithActor(domain.WithPrincipal(context.Background(), "client"), "alice")
+	_, err := s.UpdateAsset(ctx, &boundaries.UpdateAssetInput{
+		Id:           targetAsset.Id,
+		GroupId:      assetsGroup.Id,
+		Score:        ptr[float32](60),
+		CurrentValue: ptr(100.0),
+	})
+
+	if !assert.Nil(err) ||
+		!assert.Len(audit.mockedDatabase, 1) {
+		t.FailNow()
+	}
+	entry := audit.mockedDatabase[0]
+	if !assert.Equal("alice", entry.Actor) ||
+		!assert.Equal("client", entry.Principal) ||
+		!assert.Equal(domain.AUDIT_UPDATE_ASSET, entry.Operation) ||
+		!assert.Equal(targetAsset.Id, entry.AssetId) ||
+		!assert.Len(entry.Changes, 1) ||
+		!assert.Equal("Score", entry.Changes[0].Field) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_FilterAuditTrail(t *testing.T) {
+	assert := assert.New(t)
+	uc := &mockedAuditUseCase{}
+	eng := gin.New()
+	eng.Use(ActorMiddleware())
+	eng.GET("/v1/assetsGroup/:id/audit", NewAuditHandler(uc).HandleGetAuditTrail)
+	group := domain.NewAssetGroup("test", nil, 0)
+
+	w := httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodGet,
+		"/v1/assetsGroup/"+group.Id.String()+"/audit?actor=alice&from=2026-01-01T00:00:00Z", nil))
+
+	if !assert.Equal(http.StatusOK, w.Code) ||
+		!assert.Equal(group.Id, uc.input.GroupId) ||
+		!assert.Equal("alice", uc.input.Actor) ||
+		!assert.True(uc.input.From.Equal(time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC))) ||
+		!assert.True(uc.input.To.IsZero()) {
+		t.FailNow()
+	}
+}
+
+func (m *mockedAuditUseCase) GetAuditTrail(
+	ctx context.Context, input *boundaries.GetAuditTrailInput) []*domain.AuditEntry {
+	m.input = input
+	return []*domain.AuditEntry{}
+}
+
+func (m *mockedAuditUseCase) GetAuditTrails(
+	ctx context.Context, input *boundaries.GetAuditTrailsInput) []*domain.AuditEntry {
+	m.batches = append(m.batches, input)
+	return m.entries
+}
@@ -0,0 +1,96 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"golang.org/x/exp/slog"
+)
+
+type (
+	// ChangeJournal writes what was recorded on a group, its domain events
+	// and audit entries, or on a template, its audit entries, in the same
+	// transaction as the group or template itself. Saved groups are
+	// published to the clients streaming them.
+	ChangeJournal struct {
+		transactor ports.Transactor
+		outbox     ports.Repository[*domain.DomainEvent]
+		audit      ports.Repository[*domain.AuditEntry]
+		broker     ports.GroupUpdateBroker
+	}
+)
+
+func NewChangeJournal(
+	transactor ports.Transactor,
+	outbox ports.Repository[*domain.DomainEvent],
+	audit ports.Repository[*domain.AuditEntry],
+	broker ports.GroupUpdateBroker) *ChangeJournal {
+	return &ChangeJournal{
+		transactor: transactor,
+		outbox:     outbox,
+		audit:      audit,
+		broker:     broker,
+	}
+}
+
+// Save runs write and stores the group events in the outbox and its
+// audit entries, attributed to the actor and principal of ctx, in one
+// transaction. Nothing is stored when write fails or an event couldn't
+// be recorded. Once stored, the group is published to its streams.
+func (j *ChangeJournal) Save(
+	ctx context.Context,
+	group *domain.AssetsGroup,
+	write func(ctx context.Context) error) error {
+	events, err := group.PullEvents()
+	audit := group.PullAudit()
+	if err != nil {
+		return err
+	}
+	actor, principal := domain.ActorFromContext(ctx), domain.PrincipalFromContext(ctx)
+
+	if err := j.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := write(ctx); err != nil {
+			return err
+		}
+		for _, e := range events {
+			j.outbox.Insert(ctx, e)
+		}
+		for _, e := range audit {
+			e.Actor = actor
+			e.Principal = principal
+			j.audit.Insert(ctx, e)
+		}
+		return nil
+	}); err != nil {
+		return err
+	}
+	if err := j.broker.Publish(ctx, group); err != nil {
+		slog.WarnCtx(ctx, "group update not streamed", "group_id", group.Id, "error", err)
+	}
+	return nil
+}
+
+// SaveTemplate runs write and stores the template audit entries,
+// attributed to the actor and principal of ctx, in one transaction.
+// Nothing is stored when write fails.
+func (j *ChangeJournal) SaveTemplate(
+	ctx context.Context,
+	template *domain.PortfolioTemplate,
+	write func(ctx context.Context) error) error {
+	audit := template.PullAudit()
+	actor, principal := domain.ActorFromContext(ctx), domain.PrincipalFromContext(ctx)
+
+	return j.transactor.WithinTransaction(ctx, func(ctx context.Context) error {
+		if err := write(ctx); err != nil {
+			return err
+		}
+		for _, e := range audit {
+			e.Actor = actor
+			e.Principal = principal
+			j.audit.Insert(ctx, e)
+		}
+		return nil
+	})
+}
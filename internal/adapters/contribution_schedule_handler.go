@@ -0,0 +1,111 @@
+package adapters
+
+import (
+	"net/http"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type (
+	ContributionScheduleHandler struct {
+		useCase ports.ContributionScheduleUseCase
+	}
+)
+
+func (h *ContributionScheduleHandler) HandleCreateContributionSchedule(c *gin.Context) {
+	input := &boundaries.CreateContributionScheduleInput{}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
+		return
+	}
+
+	res, err := h.useCase.CreateContributionSchedule(c, input)
+
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusPreconditionFailed, newErrorResult(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (h *ContributionScheduleHandler) HandleDeleteContributionSchedule(c *gin.Context) {
+	input := &boundaries.DeleteContributionScheduleInput{}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
+		return
+	}
+
+	res, err := h.useCase.DeleteContributionSchedule(c, input)
+
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusPreconditionFailed, newErrorResult(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *ContributionScheduleHandler) HandleGetRebalancePlans(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newErrorResult(err.Error()))
+		return
+	}
+
+	input := &boundaries.GetRebalancePlansInput{
+		GroupId: id,
+		Status:  c.Query("status"),
+	}
+	res := h.useCase.GetRebalancePlans(c, input)
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *ContributionScheduleHandler) HandleApproveRebalancePlan(c *gin.Context) {
+	input := &boundaries.ReviewRebalancePlanInput{}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
+		return
+	}
+
+	res, err := h.useCase.ApproveRebalancePlan(c, input)
+
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusPreconditionFailed, newErrorResult(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *ContributionScheduleHandler) HandleRejectRebalancePlan(c *gin.Context) {
+	input := &boundaries.ReviewRebalancePlanInput{}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
+		return
+	}
+
+	res, err := h.useCase.RejectRebalancePlan(c, input)
+
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusPreconditionFailed, newErrorResult(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func NewContributionScheduleHandler(uc ports.ContributionScheduleUseCase) *ContributionScheduleHandler {
+	return &ContributionScheduleHandler{
+		useCase: uc,
+	}
+}
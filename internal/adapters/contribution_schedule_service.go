@@ -0,0 +1,266 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"golang.org/x/exp/slices"
+	"golang.org/x/exp/slog"
+)
+
+type (
+	ContributionScheduleService struct {
+		repository     ports.Repository[*domain.AssetsGroup]
+		planRepository ports.Repository[*domain.RebalancePlan]
+		publisher      ports.NotificationPublisher
+		journal        *ChangeJournal
+		now            func() time.Time
+	}
+	ContributionScheduler struct {
+		useCase  ports.ContributionScheduleUseCase
+		interval time.Duration
+	}
+)
+
+func NewContributionScheduleUseCase(
+	repository ports.Repository[*domain.AssetsGroup],
+	planRepository ports.Repository[*domain.RebalancePlan],
+	publisher ports.NotificationPublisher,
+	journal *ChangeJournal) ports.ContributionScheduleUseCase {
+	return &ContributionScheduleService{
+		repository:     repository,
+		planRepository: planRepository,
+		publisher:      publisher,
+		journal:        journal,
+		now:            time.Now,
+	}
+}
+
+func (css *ContributionScheduleService) CreateContributionSchedule(
+	ctx context.Context, input *boundaries.CreateContributionScheduleInput) (_ *domain.AssetsGroup, err error) {
+	defer func() { logMutation(ctx, "CreateContributionSchedule", err, "group_id", input.GroupId) }()
+	assetsGroup := css.repository.GetFirst(ctx, notDeleted(map[string]interface{}{
+		"id": input.GroupId,
+	}))
+
+	if assetsGroup == nil {
+		return nil, domain.ErrAssetsGroupNotFound
+	}
+
+	schedule, err := domain.NewContributionSchedule(input.Cron, input.Amount, css.now())
+	if err != nil {
+		return nil, err
+	}
+	assetsGroup.ContributionSchedules = append(assetsGroup.ContributionSchedules, schedule)
+	assetsGroup.RecordAudit(domain.AUDIT_CREATE_CONTRIBUTION_SCHEDULE, uuid.Nil, []*domain.FieldChange{{
+		Field: "ContributionSchedules",
+		After: schedule,
+	}})
+
+	if err := css.journal.Save(ctx, assetsGroup, func(ctx context.Context) error {
+		return replaceGroup(ctx, css.repository, assetsGroup)
+	}); err != nil {
+		return nil, err
+	}
+
+	return assetsGroup, nil
+}
+
+func (css *ContributionScheduleService) DeleteContributionSchedule(
+	ctx context.Context, input *boundaries.DeleteContributionScheduleInput) (_ *domain.AssetsGroup, err error) {
+	defer func() {
+		logMutation(ctx, "DeleteContributionSchedule", err, "group_id", input.GroupId, "schedule_id", input.Id)
+	}()
+	assetsGroup := css.repository.GetFirst(ctx, notDeleted(map[string]interface{}{
+		"id": input.GroupId,
+	}))
+
+	if assetsGroup == nil {
+		return nil, domain.ErrAssetsGroupNotFound
+	}
+
+	idx := slices.IndexFunc(assetsGroup.ContributionSchedules, func(cs *domain.ContributionSchedule) bool {
+		return cs.Id == input.Id
+	})
+	if idx < 0 {
+		return nil, domain.ErrScheduleNotFound
+	}
+	assetsGroup.RecordAudit(domain.AUDIT_DELETE_CONTRIBUTION_SCHEDULE, uuid.Nil, []*domain.FieldChange{{
+		Field:  "ContributionSchedules",
+		Before: assetsGroup.ContributionSchedules[idx],
+	}})
+	assetsGroup.ContributionSchedules = slices.Delete(assetsGroup.ContributionSchedules, idx, idx+1)
+
+	if err := css.journal.Save(ctx, assetsGroup, func(ctx context.Context) error {
+		return replaceGroup(ctx, css.repository, assetsGroup)
+	}); err != nil {
+		return nil, err
+	}
+
+	return assetsGroup, nil
+}
+
+// GenerateDueRebalancePlans creates a pending plan for every schedule
+// whose occurrence has come and moves the schedule to its next one. The
+// plans of a group changed meanwhile are left for the next poll, so they
+// aren't made twice, and are stored in the same transaction that moves
+// its schedules, so none is lost either.
+func (css *ContributionScheduleService) GenerateDueRebalancePlans(ctx context.Context) []*domain.RebalancePlan {
+	now := css.now()
+	groups := css.repository.GetAll(ctx, notDeleted(map[string]interface{}{
+		"contributionschedules.nextrunat": map[string]interface{}{
+			"$lte": now,
+		},
+	}))
+
+	plans := []*domain.RebalancePlan{}
+	for _, g := range groups {
+		// plans aim for the targets in effect today, which balancing saves
+		// once the plan is approved
+		targets := g.Clone()
+		targets.FollowGlidePaths(now)
+		due := []*domain.RebalancePlan{}
+		for _, cs := range g.ContributionSchedules {
+			if !cs.IsDue(now) {
+				continue
+			}
+			due = append(due, domain.NewRebalancePlan(targets, cs.Id, cs.Amount, now))
+			cs.Advance(now)
+		}
+
+		if err := css.journal.Save(ctx, g, func(ctx context.Context) error {
+			if err := replaceGroup(ctx, css.repository, g); err != nil {
+				return err
+			}
+			for _, plan := range due {
+				css.planRepository.Insert(ctx, plan)
+			}
+			return nil
+		}); err != nil {
+			slog.WarnCtx(ctx, "rebalance plans postponed", "group_id", g.Id, "error", err)
+			continue
+		}
+		for _, plan := range due {
+			css.publisher.Publish(ctx, domain.NewNotification(
+				domain.NOTIFICATION_PLAN_GENERATED, g.Id, plan, now))
+			plans = append(plans, plan)
+		}
+	}
+
+	return plans
+}
+
+func (css *ContributionScheduleService) ApproveRebalancePlan(
+	ctx context.Context, input *boundaries.ReviewRebalancePlanInput) (_ *domain.RebalancePlan, err error) {
+	defer func() { logMutation(ctx, "ApproveRebalancePlan", err, "plan_id", input.Id) }()
+	plan := css.planRepository.GetFirst(ctx, map[string]interface{}{
+		"id": input.Id,
+	})
+
+	if plan == nil {
+		return nil, domain.ErrRebalancePlanNotFound
+	}
+
+	assetsGroup := css.repository.GetFirst(ctx, notDeleted(map[string]interface{}{
+		"id": plan.GroupId,
+	}))
+
+	if assetsGroup == nil {
+		return nil, domain.ErrAssetsGroupNotFound
+	}
+
+	if err := plan.Review(true, css.now()); err != nil {
+		return nil, err
+	}
+	before := map[uuid.UUID]domain.Asset{}
+	for _, a := range assetsGroup.Assets {
+		before[a.Id] = *a
+	}
+	plan.Apply(assetsGroup)
+	for _, a := range assetsGroup.Assets {
+		assetsGroup.RecordAssetChanges(domain.AUDIT_APPLY_REBALANCE_PLAN, before[a.Id], a)
+	}
+	balance(ctx, assetsGroup)
+
+	if err := css.journal.Save(ctx, assetsGroup, func(ctx context.Context) error {
+		if err := replaceGroup(ctx, css.repository, assetsGroup); err != nil {
+			return err
+		}
+		css.planRepository.Replace(ctx, map[string]interface{}{
+			"id": plan.Id,
+		}, plan)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	publishGroupModified(ctx, css.publisher, assetsGroup)
+
+	return plan, nil
+}
+
+func (css *ContributionScheduleService) RejectRebalancePlan(
+	ctx context.Context, input *boundaries.ReviewRebalancePlanInput) (_ *domain.RebalancePlan, err error) {
+	defer func() { logMutation(ctx, "RejectRebalancePlan", err, "plan_id", input.Id) }()
+	plan := css.planRepository.GetFirst(ctx, map[string]interface{}{
+		"id": input.Id,
+	})
+
+	if plan == nil {
+		return nil, domain.ErrRebalancePlanNotFound
+	}
+
+	if err := plan.Review(false, css.now()); err != nil {
+		return nil, err
+	}
+
+	css.planRepository.Replace(ctx, map[string]interface{}{
+		"id": plan.Id,
+	}, plan)
+
+	return plan, nil
+}
+
+func (css *ContributionScheduleService) GetRebalancePlans(
+	ctx context.Context, input *boundaries.GetRebalancePlansInput) []*domain.RebalancePlan {
+	filter := map[string]interface{}{
+		"groupid": input.GroupId,
+	}
+	if input.Status != "" {
+		filter["status"] = input.Status
+	}
+
+	return css.planRepository.GetAll(ctx, filter)
+}
+
+func NewContributionScheduler(
+	uc ports.ContributionScheduleUseCase,
+	cfg *viper.Viper) *ContributionScheduler {
+	return &ContributionScheduler{
+		useCase:  uc,
+		interval: cfg.GetDuration("schedules.pollInterval"),
+	}
+}
+
+// Start polls for due schedules on every interval until ctx is done.
+// A zero interval disables the scheduler.
+func (s *ContributionScheduler) Start(ctx context.Context) {
+	if s.interval <= 0 {
+		return
+	}
+	t := time.NewTicker(s.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			s.useCase.GenerateDueRebalancePlans(ctx)
+		}
+	}
+}
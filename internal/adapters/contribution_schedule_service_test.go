@@ -0,0 +1,98 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Should_GenerateDueRebalancePlans(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+	asset := domain.NewAsset("test", 100, 100, 100, 100, 0, true)
+	assetsGroup := domain.NewAssetGroup("test", []*domain.Asset{asset}, 0)
+	schedule, _ := domain.NewContributionSchedule("0 9 1 * *", 2000, now.AddDate(0, -1, 0))
+	assetsGroup.ContributionSchedules = []*domain.ContributionSchedule{schedule}
+
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetAll = func(filter map[string]interface{}) []*domain.AssetsGroup {
+		return []*domain.AssetsGroup{assetsGroup}
+	}
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		return assetsGroup
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {
+		assetsGroup = entity
+	}
+	pr := newMockedRepository[*domain.RebalancePlan]()
+	pr.mockInsert = func(e *domain.RebalancePlan) {
+		pr.mockedDatabase = append(pr.mockedDatabase, e)
+	}
+	pr.mockGetFirst = func(filter map[string]interface{}) *domain.RebalancePlan {
+		return pr.mockedDatabase[0]
+	}
+	pr.mockReplace = func(filter map[string]interface{}, entity *domain.RebalancePlan) {}
+
+	s := NewContributionScheduleUseCase(r, pr, &mockedNotificationPublisher{}, newMockedChangeJournal()).(*ContributionScheduleService)
+	s.now = func() time.Time { return now }
+
+	plans := s.GenerateDueRebalancePlans(context.Background())
+	if !assert.Len(plans, 1) ||
+		!assert.Len(pr.mockedDatabase, 1) ||
+		!assert.EqualValues(2000, plans[0].Items[0].Amount) ||
+		!assert.Equal(now.AddDate(0, 1, 0), schedule.NextRunAt) {
+		t.FailNow()
+	}
+
+	plan, err := s.ApproveRebalancePlan(context.Background(), &boundaries.ReviewRebalancePlanInput{
+		Id: plans[0].Id,
+	})
+	if !assert.Nil(err) ||
+		!assert.Equal(domain.REBALANCE_PLAN_APPROVED, plan.Status) ||
+		!assert.EqualValues(2100, asset.CurrentValue) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_AuditContributionSchedules(t *testing.T) {
+	assert := assert.New(t)
+	assetsGroup := domain.NewAssetGroup("test", []*domain.Asset{domain.NewAsset("test", 100, 100, 100, 100, 0, true)}, 0)
+
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		return assetsGroup
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {}
+	audit := newMockedInsertingRepository[*domain.AuditEntry]()
+	journal := NewChangeJournal(&mockedTransactor{}, newMockedInsertingRepository[*domain.DomainEvent](), audit,
+		NewInProcessGroupUpdateBroker())
+	s := NewContributionScheduleUseCase(r, newMockedRepository[*domain.RebalancePlan](), &mockedNotificationPublisher{}, journal)
+
+	_, err := s.CreateContributionSchedule(context.Background(), &boundaries.CreateContributionScheduleInput{
+		GroupId: assetsGroup.Id,
+		Cron:    "0 9 1 * *",
+		Amount:  2000,
+	})
+	if !assert.Nil(err) || !assert.Len(audit.mockedDatabase, 1) {
+		t.FailNow()
+	}
+	schedule := assetsGroup.ContributionSchedules[0]
+	assert.Equal(domain.AUDIT_CREATE_CONTRIBUTION_SCHEDULE, audit.mockedDatabase[0].Operation)
+	assert.Equal(schedule, audit.mockedDatabase[0].Changes[0].After)
+
+	_, err = s.DeleteContributionSchedule(context.Background(), &boundaries.DeleteContributionScheduleInput{
+		GroupId: assetsGroup.Id,
+		Id:      schedule.Id,
+	})
+	if !assert.Nil(err) || !assert.Len(audit.mockedDatabase, 2) {
+		t.FailNow()
+	}
+	assert.Equal(domain.AUDIT_DELETE_CONTRIBUTION_SCHEDULE, audit.mockedDatabase[1].Operation)
+	assert.Equal(schedule, audit.mockedDatabase[1].Changes[0].Before)
+	assert.Empty(assetsGroup.ContributionSchedules)
+}
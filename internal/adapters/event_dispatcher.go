@@ -0,0 +1,90 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/spf13/viper"
+	"golang.org/x/exp/slices"
+	"golang.org/x/exp/slog"
+)
+
+type (
+	EventDispatcherService struct {
+		outbox ports.Repository[*domain.DomainEvent]
+		sinks  []ports.EventSink
+		now    func() time.Time
+	}
+	EventDispatcherJob struct {
+		useCase  ports.EventDispatcherUseCase
+		interval time.Duration
+	}
+)
+
+func NewEventDispatcherUseCase(
+	outbox ports.Repository[*domain.DomainEvent],
+	sinks []ports.EventSink) ports.EventDispatcherUseCase {
+	return &EventDispatcherService{
+		outbox: outbox,
+		sinks:  sinks,
+		now:    time.Now,
+	}
+}
+
+// DispatchPendingEvents publishes the undispatched events to every sink
+// in the order they occurred. It stops at the first failure so the
+// order is kept, and the remaining events are retried on the next run.
+func (eds *EventDispatcherService) DispatchPendingEvents(ctx context.Context) int {
+	events := eds.outbox.GetAll(ctx, map[string]interface{}{
+		"dispatchedat": nil,
+	})
+	slices.SortStableFunc(events, func(a, b *domain.DomainEvent) bool {
+		return a.OccurredAt.Before(b.OccurredAt)
+	})
+
+	for i, e := range events {
+		for _, s := range eds.sinks {
+			if err := s.Publish(ctx, e); err != nil {
+				slog.ErrorCtx(ctx, "event dispatch failed", "event_id", e.Id, "event_type", e.Type, "error", err)
+				return i
+			}
+		}
+		now := eds.now()
+		e.DispatchedAt = &now
+		eds.outbox.Replace(ctx, map[string]interface{}{
+			"id": e.Id,
+		}, e)
+	}
+
+	return len(events)
+}
+
+func NewEventDispatcherJob(
+	uc ports.EventDispatcherUseCase,
+	cfg *viper.Viper) *EventDispatcherJob {
+	return &EventDispatcherJob{
+		useCase:  uc,
+		interval: cfg.GetDuration("events.dispatchInterval"),
+	}
+}
+
+// Start dispatches pending events on every interval until ctx is done.
+// A zero interval disables the job.
+func (j *EventDispatcherJob) Start(ctx context.Context) {
+	if j.interval <= 0 {
+		return
+	}
+	t := time.NewTicker(j.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			j.useCase.DispatchPendingEvents(ctx)
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	mockedEventSink struct {
+		published []*domain.DomainEvent
+		failOn    string
+	}
+)
+
+func Test_Should_DispatchPendingEventsInOrder(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+	groupId := uuid.New()
+	removed := newDomainEvent(groupId, domain.AssetRemoved{}, now.Add(time.Second))
+	added := newDomainEvent(groupId, domain.AssetAdded{}, now)
+	outbox := newMockedRepository[*domain.DomainEvent]()
+	outbox.mockGetAll = func(filter map[string]interface{}) []*domain.DomainEvent {
+		return []*domain.DomainEvent{removed, added}
+	}
+	outbox.mockReplace = func(filter map[string]interface{}, entity *domain.DomainEvent) {}
+	sink := &mockedEventSink{}
+	s := NewEventDispatcherUseCase(outbox, []ports.EventSink{sink})
+
+	dispatched := s.DispatchPendingEvents(context.Background())
+
+	if !assert.Equal(2, dispatched) ||
+		!assert.Equal([]*domain.DomainEvent{added, removed}, sink.published) ||
+		!assert.NotNil(added.DispatchedAt) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_StopDispatchingOnSinkFailure(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+	groupId := uuid.New()
+	added := newDomainEvent(groupId, domain.AssetAdded{}, now)
+	removed := newDomainEvent(groupId, domain.AssetRemoved{}, now.Add(time.Second))
+	outbox := newMockedRepository[*domain.DomainEvent]()
+	outbox.mockGetAll = func(filter map[string]interface{}) []*domain.DomainEvent {
+		return []*domain.DomainEvent{added, removed}
+	}
+	outbox.mockReplace = func(filter map[string]interface{}, entity *domain.DomainEvent) {}
+	s := NewEventDispatcherUseCase(outbox, []ports.EventSink{
+		&mockedEventSink{failOn: domain.EVENT_ASSET_ADDED},
+	})
+
+	dispatched := s.DispatchPendingEvents(context.Background())
+
+	if !assert.Equal(0, dispatched) ||
+		!assert.Nil(added.DispatchedAt) ||
+		!assert.Nil(removed.DispatchedAt) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_RecordEventsWhenUpdatingAsset(t *testing.T) {
+	assert := assert.New(t)
+	targetAsset := domain.NewAsset("test", 50, 100, 100, 100, 0, true)
+	assetsGroup := domain.NewAssetGroup("test", []*domain.Asset{targetAsset}, 0)
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		return assetsGroup
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {}
+	outbox := newMockedInsertingRepository[*domain.DomainEvent]()
+	s := NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{},
+		NewChangeJournal(&mockedTransactor{}, outbox, newMockedInsertingRepository[*domain.AuditEntry](),
+			NewInProcessGroupUpdateBroker()))
+
+	_, err := s.UpdateAsset(context.Background(), &boundaries.UpdateAssetInput{
+		Id:           targetAsset.Id,
+		GroupId:      assetsGroup.Id,
+		Score:        ptr[float32](50),
+		CurrentValue: ptr(150.0),
+	})
+
+	if !assert.Nil(err) ||
+		!assert.Len(outbox.mockedDatabase, 1) ||
+		!assert.Equal(domain.EVENT_ASSET_VALUE_CHANGED, outbox.mockedDatabase[0].Type) {
+		t.FailNow()
+	}
+}
+
+func (ms *mockedEventSink) Publish(ctx context.Context, e *domain.DomainEvent) error {
+	if e.Type == ms.failOn {
+		return errors.New("unavailable")
+	}
+	ms.published = append(ms.published, e)
+	return nil
+}
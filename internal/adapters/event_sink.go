@@ -0,0 +1,238 @@
+package adapters
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/spf13/viper"
+	"golang.org/x/exp/slices"
+)
+
+type (
+	EventHandler func(ctx context.Context, e *domain.DomainEvent)
+
+	InProcessEventBus struct {
+		mu       sync.RWMutex
+		handlers map[string][]*eventSubscription
+	}
+	eventSubscription struct {
+		handler EventHandler
+	}
+	// NatsEventSink speaks the NATS client protocol directly, publishing
+	// each event on "<subjectPrefix>.<event type>".
+	NatsEventSink struct {
+		mu            sync.Mutex
+		address       string
+		subjectPrefix string
+		timeout       time.Duration
+		conn          net.Conn
+		reader        *bufio.Reader
+	}
+	// KafkaRestEventSink produces events through a Kafka REST Proxy,
+	// keyed by aggregate so a group's events stay in one partition.
+	KafkaRestEventSink struct {
+		client  *http.Client
+		baseUrl string
+		topic   string
+	}
+	kafkaRestRecords struct {
+		Records []kafkaRestRecord `json:"records"`
+	}
+	kafkaRestRecord struct {
+		Key   string              `json:"key"`
+		Value *domain.DomainEvent `json:"value"`
+	}
+)
+
+// NewEventSinks builds the sinks listed under "events.sinks", which may
+// hold "inprocess", "nats" and "kafka".
+func NewEventSinks(cfg *viper.Viper, bus *InProcessEventBus) ([]ports.EventSink, error) {
+	sinks := []ports.EventSink{}
+	for _, name := range cfg.GetStringSlice("events.sinks") {
+		switch name {
+		case "inprocess":
+			sinks = append(sinks, bus)
+		case "nats":
+			sinks = append(sinks, NewNatsEventSink(
+				cfg.GetString("events.nats.address"),
+				cfg.GetString("events.nats.subjectPrefix"),
+				cfg.GetDuration("events.nats.timeout")))
+		case "kafka":
+			sinks = append(sinks, NewKafkaRestEventSink(
+				cfg.GetString("events.kafka.restProxyUrl"),
+				cfg.GetString("events.kafka.topic"),
+				cfg.GetDuration("events.kafka.timeout")))
+		default:
+			return nil, fmt.Errorf("unknown event sink %q", name)
+		}
+	}
+
+	return sinks, nil
+}
+
+func NewInProcessEventBus() *InProcessEventBus {
+	return &InProcessEventBus{
+		handlers: map[string][]*eventSubscription{},
+	}
+}
+
+// Subscribe registers h for the given event type, or for every event
+// when eventType is empty. Calling the returned func unsubscribes it.
+func (b *InProcessEventBus) Subscribe(eventType string, h EventHandler) func() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	sub := &eventSubscription{handler: h}
+	b.handlers[eventType] = append(b.handlers[eventType], sub)
+
+	return func() {
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		subs := b.handlers[eventType]
+		if i := slices.Index(subs, sub); i >= 0 {
+			b.handlers[eventType] = slices.Delete(subs, i, i+1)
+		}
+	}
+}
+
+func (b *InProcessEventBus) Publish(ctx context.Context, e *domain.DomainEvent) error {
+	b.mu.RLock()
+	subs := append(append([]*eventSubscription{}, b.handlers[e.Type]...), b.handlers[""]...)
+	b.mu.RUnlock()
+
+	for _, sub := range subs {
+		sub.handler(ctx, e)
+	}
+
+	return nil
+}
+
+func NewNatsEventSink(address, subjectPrefix string, timeout time.Duration) *NatsEventSink {
+	if timeout <= 0 {
+		timeout = 5 * time.Second
+	}
+	return &NatsEventSink{
+		address:       address,
+		subjectPrefix: subjectPrefix,
+		timeout:       timeout,
+	}
+}
+
+// Publish sends the event followed by a PING and waits for the PONG, so
+// a nil error means the server has processed the message.
+func (s *NatsEventSink) Publish(ctx context.Context, e *domain.DomainEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	if s.conn == nil {
+		if err := s.connect(ctx); err != nil {
+			return err
+		}
+	}
+
+	s.conn.SetDeadline(time.Now().Add(s.timeout))
+	_, err = fmt.Fprintf(s.conn, "PUB %s.%s %d\r\n%s\r\nPING\r\n", s.subjectPrefix, e.Type, len(body), body)
+	if err == nil {
+		err = s.waitPong()
+	}
+	if err != nil {
+		s.conn.Close()
+		s.conn = nil
+	}
+
+	return err
+}
+
+func (s *NatsEventSink) connect(ctx context.Context) error {
+	d := net.Dialer{Timeout: s.timeout}
+	conn, err := d.DialContext(ctx, "tcp", s.address)
+	if err != nil {
+		return err
+	}
+	conn.SetDeadline(time.Now().Add(s.timeout))
+	s.conn = conn
+	s.reader = bufio.NewReader(conn)
+
+	line, err := s.reader.ReadString('\n')
+	if err == nil && !strings.HasPrefix(line, "INFO") {
+		err = fmt.Errorf("unexpected nats greeting %q", strings.TrimSpace(line))
+	}
+	if err == nil {
+		_, err = fmt.Fprint(conn, "CONNECT {\"verbose\":false,\"pedantic\":false,\"name\":\"assets-balancer\"}\r\nPING\r\n")
+	}
+	if err == nil {
+		err = s.waitPong()
+	}
+	if err != nil {
+		conn.Close()
+		s.conn = nil
+	}
+
+	return err
+}
+
+func (s *NatsEventSink) waitPong() error {
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			return err
+		}
+		switch line = strings.TrimSpace(line); {
+		case line == "PONG":
+			return nil
+		case line == "PING":
+			fmt.Fprint(s.conn, "PONG\r\n")
+		case strings.HasPrefix(line, "-ERR"):
+			return errors.New(line)
+		}
+	}
+}
+
+func NewKafkaRestEventSink(baseUrl, topic string, timeout time.Duration) *KafkaRestEventSink {
+	return &KafkaRestEventSink{
+		client:  &http.Client{Timeout: timeout},
+		baseUrl: strings.TrimSuffix(baseUrl, "/"),
+		topic:   topic,
+	}
+}
+
+func (s *KafkaRestEventSink) Publish(ctx context.Context, e *domain.DomainEvent) error {
+	body, err := json.Marshal(&kafkaRestRecords{
+		Records: []kafkaRestRecord{{Key: e.AggregateId.String(), Value: e}},
+	})
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost,
+		s.baseUrl+"/topics/"+s.topic, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/vnd.kafka.json.v2+json")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("kafka rest proxy responded with status %d", res.StatusCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+package adapters
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Should_PublishToInProcessSubscribers(t *testing.T) {
+	assert := assert.New(t)
+	bus := NewInProcessEventBus()
+	received := []string{}
+	bus.Subscribe(domain.EVENT_ASSET_ADDED, func(ctx context.Context, e *domain.DomainEvent) {
+		received = append(received, "typed")
+	})
+	bus.Subscribe("", func(ctx context.Context, e *domain.DomainEvent) {
+		received = append(received, "all")
+	})
+
+	bus.Publish(context.Background(), newDomainEvent(uuid.New(), domain.AssetAdded{}, time.Now()))
+	bus.Publish(context.Background(), newDomainEvent(uuid.New(), domain.AssetRemoved{}, time.Now()))
+
+	assert.Equal([]string{"typed", "all", "all"}, received)
+}
+
+func Test_Should_FailOnUnknownEventSinks(t *testing.T) {
+	assert := assert.New(t)
+	cfg := viper.New()
+	cfg.Set("events.sinks", []string{"inprocess", "rabbitmq"})
+
+	sinks, err := NewEventSinks(cfg, NewInProcessEventBus())
+
+	assert.Nil(sinks)
+	assert.EqualError(err, `unknown event sink "rabbitmq"`)
+}
+
+func Test_Should_PublishToNats(t *testing.T) {
+	assert := assert.New(t)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	subjects := make(chan string, 1)
+	go serveNatsStub(l, subjects)
+	s := NewNatsEventSink(l.Addr().String(), "balancer", time.Second)
+
+	err = s.Publish(context.Background(), newDomainEvent(uuid.New(), domain.AssetAdded{}, time.Now()))
+
+	if !assert.Nil(err) ||
+		!assert.Equal("balancer."+domain.EVENT_ASSET_ADDED, <-subjects) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_PublishToKafkaRestProxy(t *testing.T) {
+	assert := assert.New(t)
+	var path string
+	records := &kafkaRestRecords{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		json.NewDecoder(r.Body).Decode(records)
+	}))
+	defer srv.Close()
+	e := newDomainEvent(uuid.New(), domain.AssetAdded{}, time.Now())
+
+	err := NewKafkaRestEventSink(srv.URL, "events", time.Second).Publish(context.Background(), e)
+
+	if !assert.Nil(err) ||
+		!assert.Equal("/topics/events", path) ||
+		!assert.Len(records.Records, 1) ||
+		!assert.Equal(e.AggregateId.String(), records.Records[0].Key) {
+		t.FailNow()
+	}
+}
+
+// serveNatsStub plays the server side of a NATS session, answering
+// PINGs and handing over the subject of every published message.
+func serveNatsStub(l net.Listener, subjects chan<- string) {
+	conn, err := l.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	conn.Write([]byte("INFO {}\r\n"))
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			return
+		}
+		fields := strings.Fields(line)
+		switch fields[0] {
+		case "PING":
+			conn.Write([]byte("PONG\r\n"))
+		case "PUB":
+			size, _ := strconv.Atoi(fields[len(fields)-1])
+			r.Discard(size + 2)
+			subjects <- fields[1]
+		}
+	}
+}
+
+func newDomainEvent(aggregateId uuid.UUID, e domain.Event, now time.Time) *domain.DomainEvent {
+	de, err := domain.NewDomainEvent(aggregateId, e, now)
+	if err != nil {
+		panic(err)
+	}
+	return de
+}
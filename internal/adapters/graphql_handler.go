@@ -0,0 +1,78 @@
+package adapters
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/gin-gonic/gin"
+	"github.com/graph-gophers/graphql-go"
+	"github.com/spf13/viper"
+)
+
+//go:embed schema.graphql
+var graphQlSchema string
+
+const (
+	defaultGraphQlMaxDepth       = 8
+	defaultGraphQlMaxQueryLength = 8192
+)
+
+type (
+	GraphQlHandler struct {
+		schema *graphql.Schema
+	}
+	graphQlRequest struct {
+		Query         string                 `json:"query" binding:"required"`
+		OperationName string                 `json:"operationName"`
+		Variables     map[string]interface{} `json:"variables"`
+	}
+)
+
+// HandleGraphQl executes a query or mutation. GraphQL errors are part of
+// the response body, so it answers 200 once the request is well formed.
+func (h *GraphQlHandler) HandleGraphQl(c *gin.Context) {
+	req := &graphQlRequest{}
+
+	if err := c.ShouldBindJSON(req); err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
+		return
+	}
+
+	res := h.schema.Exec(c, req.Query, req.OperationName, req.Variables)
+
+	c.JSON(http.StatusOK, res)
+}
+
+// NewGraphQlHandler bounds the queries it runs by "graphql.maxDepth",
+// how deep selections nest, and "graphql.maxQueryLength", which caps
+// how many fields and aliases a query can ask for. Both are on unless
+// set to a negative value.
+func NewGraphQlHandler(
+	cfg *viper.Viper,
+	uc ports.AssetBalancerUseCase,
+	auc ports.AuditUseCase) *GraphQlHandler {
+	maxDepth := cfg.GetInt("graphql.maxDepth")
+	if maxDepth == 0 {
+		maxDepth = defaultGraphQlMaxDepth
+	}
+	maxQueryLength := cfg.GetInt("graphql.maxQueryLength")
+	if maxQueryLength == 0 {
+		maxQueryLength = defaultGraphQlMaxQueryLength
+	}
+	opts := []graphql.SchemaOpt{}
+	if maxDepth > 0 {
+		opts = append(opts, graphql.MaxDepth(maxDepth))
+	}
+	if maxQueryLength > 0 {
+		opts = append(opts, graphql.MaxQueryLength(maxQueryLength))
+	}
+
+	return &GraphQlHandler{
+		schema: graphql.MustParseSchema(graphQlSchema, &rootResolver{
+			useCase:      uc,
+			auditUseCase: auc,
+		}, opts...),
+	}
+}
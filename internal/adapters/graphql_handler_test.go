@@ -0,0 +1,171 @@
+package adapters
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	graphQlTestResponse struct {
+		Data   json.RawMessage
+		Errors []struct {
+			Message string
+		}
+	}
+)
+
+func serveGraphQl(h *GraphQlHandler, query string) *graphQlTestResponse {
+	eng := gin.New()
+	eng.POST("/v1/graphql", h.HandleGraphQl)
+	body, _ := json.Marshal(map[string]interface{}{"query": query})
+	w := httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/graphql", strings.NewReader(string(body))))
+	res := &graphQlTestResponse{}
+	json.Unmarshal(w.Body.Bytes(), res)
+
+	return res
+}
+
+func Test_Should_LoadHistoryOfEveryGroupInOneQuery(t *testing.T) {
+	assert := assert.New(t)
+	a := domain.NewAsset("RF", 100, 80, 100, 100, 0, true)
+	groups := []*domain.AssetsGroup{
+		domain.NewAssetGroup("first", []*domain.Asset{a}, 0),
+		domain.NewAssetGroup("second", []*domain.Asset{}, 0),
+	}
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetAll = func(filter map[string]interface{}) []*domain.AssetsGroup {
+		return groups
+	}
+	audit := &mockedAuditUseCase{
+		entries: []*domain.AuditEntry{
+			{Id: uuid.New(), GroupId: groups[0].Id, Operation: domain.AUDIT_CREATE_ASSETS_GROUP},
+			{Id: uuid.New(), GroupId: groups[0].Id, AssetId: a.Id, Operation: domain.AUDIT_UPDATE_ASSET},
+			{Id: uuid.New(), GroupId: groups[1].Id, Operation: domain.AUDIT_CREATE_ASSETS_GROUP},
+		},
+	}
+	h := NewGraphQlHandler(viper.New(), NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, newMockedChangeJournal()), audit)
+
+	res := serveGraphQl(h, `{
+		groups {
+			label
+			performance { variation }
+			history { operation }
+			assets { label history { operation } }
+		}
+	}`)
+	data := &struct {
+		Groups []struct {
+			Label       string
+			Performance struct{ Variation float64 }
+			History     []struct{ Operation string }
+			Assets      []struct {
+				History []struct{ Operation string }
+			}
+		}
+	}{}
+	if !assert.Empty(res.Errors) ||
+		!assert.Nil(json.Unmarshal(res.Data, data)) ||
+		!assert.Len(data.Groups, 2) ||
+		!assert.Len(audit.batches, 1) ||
+		!assert.ElementsMatch([]uuid.UUID{groups[0].Id, groups[1].Id}, audit.batches[0].GroupIds) {
+		t.FailNow()
+	}
+	assert.InDelta(0.25, data.Groups[0].Performance.Variation, 1e-9)
+	assert.Len(data.Groups[0].History, 2)
+	assert.Len(data.Groups[1].History, 1)
+	assert.Equal(domain.AUDIT_UPDATE_ASSET, data.Groups[0].Assets[0].History[0].Operation)
+}
+
+func Test_Should_ValidateGraphQlMutations(t *testing.T) {
+	assert := assert.New(t)
+	group := domain.NewAssetGroup("test", []*domain.Asset{}, 100)
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		return group
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {}
+	h := NewGraphQlHandler(viper.New(), NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, newMockedChangeJournal()),
+		&mockedAuditUseCase{})
+
+	res := serveGraphQl(h, `mutation {
+		createAsset(groupId: "`+group.Id.String()+`", input: {label: "RF", score: 120}) { id }
+	}`)
+	if !assert.Len(res.Errors, 1) ||
+		!assert.Contains(res.Errors[0].Message, "Score must be less than or equal to 100") {
+		t.FailNow()
+	}
+
+	res = serveGraphQl(h, `mutation {
+		updateAssetsGroup(id: "`+group.Id.String()+`", patch: {contributionTotal: 0}) { contributionTotal label }
+	}`)
+	if !assert.Empty(res.Errors) ||
+		!assert.JSONEq(`{"updateAssetsGroup": {"contributionTotal": 0, "label": "test"}}`, string(res.Data)) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_ApplyBatchesOverGraphQl(t *testing.T) {
+	assert := assert.New(t)
+	a := domain.NewAsset("RF", 100, 100, 100, 100, 0, true)
+	group := domain.NewAssetGroup("test", []*domain.Asset{a}, 100)
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		return group
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {
+		group = entity
+	}
+	h := NewGraphQlHandler(viper.New(), NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, newMockedChangeJournal()),
+		&mockedAuditUseCase{})
+
+	res := serveGraphQl(h, `mutation {
+		applyBatch(groupId: "`+group.Id.String()+`", operations: [
+			{op: "update", assetId: "`+a.Id.String()+`", score: 60},
+			{op: "add", label: "RV", score: 40, include: true}
+		]) { applied results { error } group { assets { label score } } }
+	}`)
+	if !assert.Empty(res.Errors) ||
+		!assert.JSONEq(`{"applyBatch": {"applied": true, "results": [{"error": null}, {"error": null}],
+			"group": {"assets": [{"label": "RF", "score": 60}, {"label": "RV", "score": 40}]}}}`, string(res.Data)) {
+		t.FailNow()
+	}
+
+	res = serveGraphQl(h, `mutation {
+		applyBatch(groupId: "`+group.Id.String()+`", operations: [{op: "remove", assetId: "`+uuid.NewString()+`"}]) {
+			applied results { error } group { id }
+		}
+	}`)
+	if !assert.Empty(res.Errors) ||
+		!assert.JSONEq(`{"applyBatch": {"applied": false, "results": [{"error": "ASSET_NOT_FOUND"}], "group": null}}`,
+			string(res.Data)) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_RejectGraphQlQueriesTooDeepOrTooLong(t *testing.T) {
+	assert := assert.New(t)
+	cfg := viper.New()
+	cfg.Set("graphql.maxDepth", 2)
+	cfg.Set("graphql.maxQueryLength", 100)
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetAll = func(filter map[string]interface{}) []*domain.AssetsGroup {
+		return []*domain.AssetsGroup{}
+	}
+	h := NewGraphQlHandler(cfg, NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, newMockedChangeJournal()),
+		&mockedAuditUseCase{})
+
+	assert.Empty(serveGraphQl(h, `{ groups { id } }`).Errors)
+	assert.NotEmpty(serveGraphQl(h, `{ groups { assets { history { id } } } }`).Errors)
+	assert.NotEmpty(serveGraphQl(h, `{ groups { `+strings.Repeat("id ", 40)+`} }`).Errors)
+}
@@ -0,0 +1,623 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/google/uuid"
+	"github.com/graph-gophers/graphql-go"
+)
+
+type (
+	rootResolver struct {
+		useCase      ports.AssetBalancerUseCase
+		auditUseCase ports.AuditUseCase
+	}
+	groupResolver struct {
+		group   *domain.AssetsGroup
+		history *historyLoader
+	}
+	assetResolver struct {
+		asset *domain.Asset
+		group *groupResolver
+	}
+	performanceResolver struct {
+		previousValue float64
+		currentValue  float64
+	}
+	auditEntryResolver struct {
+		entry *domain.AuditEntry
+	}
+	fieldChangeResolver struct {
+		change *domain.FieldChange
+	}
+	batchResultResolver struct {
+		result *boundaries.BatchAssetsResult
+		group  *groupResolver
+	}
+	batchOperationResultResolver struct {
+		result *boundaries.BatchOperationResult
+	}
+
+	// historyLoader is shared by the groups of a result. The first of them
+	// to resolve its history, or the history of an asset, loads it for all
+	// of them in a single query, so a list doesn't cost a query per item.
+	historyLoader struct {
+		auditUseCase ports.AuditUseCase
+		groupIds     []uuid.UUID
+		mu           sync.Mutex
+		loaded       map[historyFilter][]*domain.AuditEntry
+	}
+	historyFilter struct {
+		actor string
+		from  time.Time
+		to    time.Time
+	}
+
+	historyArgs struct {
+		From  *graphql.Time
+		To    *graphql.Time
+		Actor *string
+	}
+	idArgs struct {
+		Id graphql.ID
+	}
+	createAssetsGroupArgs struct {
+		Input struct {
+			Label             string
+			ContributionTotal *float64
+			Assets            *[]assetInputArgs
+		}
+	}
+	updateAssetsGroupArgs struct {
+		Id    graphql.ID
+		Patch struct {
+			Label             *string
+			ContributionTotal *float64
+		}
+	}
+	createAssetArgs struct {
+		GroupId graphql.ID
+		Input   assetInputArgs
+	}
+	updateAssetArgs struct {
+		GroupId graphql.ID
+		Id      graphql.ID
+		Patch   struct {
+			Label         *string
+			Ticker        *string
+			Quantity      *float64
+			Score         *float64
+			PreviousValue *float64
+			CurrentValue  *float64
+			Include       *bool
+		}
+	}
+	deleteAssetArgs struct {
+		GroupId graphql.ID
+		Id      graphql.ID
+	}
+	applyBatchArgs struct {
+		GroupId    graphql.ID
+		Operations []struct {
+			Op            string
+			AssetId       *graphql.ID
+			Label         *string
+			Ticker        *string
+			Quantity      *float64
+			Score         *float64
+			PreviousValue *float64
+			CurrentValue  *float64
+			Include       *bool
+		}
+	}
+	assetInputArgs struct {
+		Label         string
+		Ticker        *string
+		Quantity      *float64
+		Score         *float64
+		PreviousValue *float64
+		CurrentValue  *float64
+		Include       *bool
+	}
+)
+
+func (r *rootResolver) Groups(ctx context.Context) []*groupResolver {
+	return r.newGroupResolvers(r.useCase.GetAssetsGroups(ctx)...)
+}
+
+func (r *rootResolver) Group(ctx context.Context, args idArgs) (*groupResolver, error) {
+	id, err := uuid.Parse(string(args.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	group := r.useCase.GetAssetsGroup(ctx, &boundaries.GetAssetsGroupInput{
+		Id: id,
+	})
+	if group == nil {
+		return nil, nil
+	}
+
+	return r.newGroupResolvers(group)[0], nil
+}
+
+func (r *rootResolver) CreateAssetsGroup(ctx context.Context, args createAssetsGroupArgs) (*groupResolver, error) {
+	input := &boundaries.CreateAssetsGroupInput{
+		Label:             args.Input.Label,
+		ContributionTotal: valueOf(args.Input.ContributionTotal),
+	}
+	if args.Input.Assets != nil {
+		for _, a := range *args.Input.Assets {
+			input.Assets = append(input.Assets, a.toCreateAssetInput())
+		}
+	}
+	if err := validateInput(input); err != nil {
+		return nil, err
+	}
+
+	return r.groupResult(r.useCase.CreateAssetsGroup(ctx, input))
+}
+
+func (r *rootResolver) UpdateAssetsGroup(ctx context.Context, args updateAssetsGroupArgs) (*groupResolver, error) {
+	id, err := uuid.Parse(string(args.Id))
+	if err != nil {
+		return nil, err
+	}
+	input := &boundaries.UpdateAssetsGroup{
+		Id:                id,
+		Label:             args.Patch.Label,
+		ContributionTotal: args.Patch.ContributionTotal,
+	}
+	if err := validateInput(input); err != nil {
+		return nil, err
+	}
+
+	return r.groupResult(r.useCase.UpdateAssetsGroup(ctx, input))
+}
+
+func (r *rootResolver) DeleteAssetsGroup(ctx context.Context, args idArgs) (bool, error) {
+	id, err := uuid.Parse(string(args.Id))
+	if err != nil {
+		return false, err
+	}
+
+	if err := r.useCase.DeleteAssetsGroup(ctx, &boundaries.DeleteAssetsGroupInput{
+		Id: id,
+	}); err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (r *rootResolver) CreateAsset(ctx context.Context, args createAssetArgs) (*groupResolver, error) {
+	groupId, err := uuid.Parse(string(args.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	a := args.Input.toCreateAssetInput()
+	input := &boundaries.CreateAssetForGroupInput{
+		GroupId:       groupId,
+		Label:         a.Label,
+		Ticker:        a.Ticker,
+		Quantity:      a.Quantity,
+		Score:         a.Score,
+		PreviousValue: a.PreviousValue,
+		CurrentValue:  a.CurrentValue,
+		Include:       a.Include,
+	}
+	if err := validateInput(input); err != nil {
+		return nil, err
+	}
+
+	return r.groupResult(r.useCase.CreateAsset(ctx, input))
+}
+
+func (r *rootResolver) UpdateAsset(ctx context.Context, args updateAssetArgs) (*groupResolver, error) {
+	groupId, err := uuid.Parse(string(args.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(string(args.Id))
+	if err != nil {
+		return nil, err
+	}
+	input := &boundaries.UpdateAssetInput{
+		Id:            id,
+		GroupId:       groupId,
+		Label:         args.Patch.Label,
+		Ticker:        args.Patch.Ticker,
+		Quantity:      args.Patch.Quantity,
+		PreviousValue: args.Patch.PreviousValue,
+		CurrentValue:  args.Patch.CurrentValue,
+		Include:       args.Patch.Include,
+	}
+	if args.Patch.Score != nil {
+		score := float32(*args.Patch.Score)
+		input.Score = &score
+	}
+	if err := validateInput(input); err != nil {
+		return nil, err
+	}
+
+	return r.groupResult(r.useCase.UpdateAsset(ctx, input))
+}
+
+func (r *rootResolver) DeleteAsset(ctx context.Context, args deleteAssetArgs) (*groupResolver, error) {
+	groupId, err := uuid.Parse(string(args.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.Parse(string(args.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	return r.groupResult(r.useCase.DeleteAsset(ctx, &boundaries.DeleteAssetInput{
+		Id:      id,
+		GroupId: groupId,
+	}))
+}
+
+// ApplyBatch answers with the group as saved by the batch, read back so
+// it resolves like any other group.
+func (r *rootResolver) ApplyBatch(ctx context.Context, args applyBatchArgs) (*batchResultResolver, error) {
+	groupId, err := uuid.Parse(string(args.GroupId))
+	if err != nil {
+		return nil, err
+	}
+	input := &boundaries.BatchAssetsInput{
+		GroupId: groupId,
+	}
+	for _, op := range args.Operations {
+		var assetId uuid.UUID
+		if op.AssetId != nil {
+			if assetId, err = uuid.Parse(string(*op.AssetId)); err != nil {
+				return nil, err
+			}
+		}
+		o := &boundaries.BatchOperation{
+			Op:            op.Op,
+			AssetId:       assetId,
+			Label:         op.Label,
+			Ticker:        op.Ticker,
+			Quantity:      op.Quantity,
+			PreviousValue: op.PreviousValue,
+			CurrentValue:  op.CurrentValue,
+			Include:       op.Include,
+		}
+		if op.Score != nil {
+			score := float32(*op.Score)
+			o.Score = &score
+		}
+		input.Operations = append(input.Operations, o)
+	}
+	if err := validateInput(input); err != nil {
+		return nil, err
+	}
+
+	res, err := r.useCase.ApplyBatch(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+	out := &batchResultResolver{result: res}
+	if res.Applied {
+		if group := r.useCase.GetAssetsGroup(ctx, &boundaries.GetAssetsGroupInput{
+			Id: groupId,
+		}); group != nil {
+			out.group = r.newGroupResolvers(group)[0]
+		}
+	}
+
+	return out, nil
+}
+
+func (r *rootResolver) groupResult(group *domain.AssetsGroup, err error) (*groupResolver, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return r.newGroupResolvers(group)[0], nil
+}
+
+// newGroupResolvers resolves the groups sharing one history loader.
+func (r *rootResolver) newGroupResolvers(groups ...*domain.AssetsGroup) []*groupResolver {
+	loader := &historyLoader{
+		auditUseCase: r.auditUseCase,
+		loaded:       map[historyFilter][]*domain.AuditEntry{},
+	}
+	res := []*groupResolver{}
+	for _, g := range groups {
+		loader.groupIds = append(loader.groupIds, g.Id)
+		res = append(res, &groupResolver{
+			group:   g,
+			history: loader,
+		})
+	}
+
+	return res
+}
+
+func (l *historyLoader) load(ctx context.Context, filter historyFilter) []*domain.AuditEntry {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if entries, ok := l.loaded[filter]; ok {
+		return entries
+	}
+
+	entries := l.auditUseCase.GetAuditTrails(ctx, &boundaries.GetAuditTrailsInput{
+		GroupIds: l.groupIds,
+		Actor:    filter.actor,
+		From:     filter.from,
+		To:       filter.to,
+	})
+	l.loaded[filter] = entries
+
+	return entries
+}
+
+func (a assetInputArgs) toCreateAssetInput() boundaries.CreateAssetInput {
+	return boundaries.CreateAssetInput{
+		Label:         a.Label,
+		Ticker:        valueOf(a.Ticker),
+		Quantity:      valueOf(a.Quantity),
+		Score:         float32(valueOf(a.Score)),
+		PreviousValue: valueOf(a.PreviousValue),
+		CurrentValue:  valueOf(a.CurrentValue),
+		Include:       valueOf(a.Include),
+	}
+}
+
+func (g *groupResolver) Id() graphql.ID {
+	return graphql.ID(g.group.Id.String())
+}
+
+func (g *groupResolver) Label() string {
+	return g.group.Label
+}
+
+func (g *groupResolver) ContributionTotal() float64 {
+	return g.group.ContributionTotal
+}
+
+func (g *groupResolver) Owner() string {
+	return g.group.Owner
+}
+
+func (g *groupResolver) CurrentTotal() float64 {
+	return g.group.CurrentTotal()
+}
+
+func (g *groupResolver) Assets() []*assetResolver {
+	res := []*assetResolver{}
+	for _, a := range g.group.Assets {
+		res = append(res, &assetResolver{
+			asset: a,
+			group: g,
+		})
+	}
+
+	return res
+}
+
+func (g *groupResolver) Performance() *performanceResolver {
+	p := &performanceResolver{}
+	for _, a := range g.group.Assets {
+		if a.Include {
+			p.previousValue += a.PreviousValue
+			p.currentValue += a.CurrentValue
+		}
+	}
+
+	return p
+}
+
+func (g *groupResolver) History(ctx context.Context, args historyArgs) []*auditEntryResolver {
+	filter := historyFilter{
+		actor: valueOf(args.Actor),
+	}
+	if args.From != nil {
+		filter.from = args.From.Time
+	}
+	if args.To != nil {
+		filter.to = args.To.Time
+	}
+
+	res := []*auditEntryResolver{}
+	for _, e := range g.history.load(ctx, filter) {
+		if e.GroupId == g.group.Id {
+			res = append(res, &auditEntryResolver{entry: e})
+		}
+	}
+
+	return res
+}
+
+func (a *assetResolver) Id() graphql.ID {
+	return graphql.ID(a.asset.Id.String())
+}
+
+func (a *assetResolver) Label() string {
+	return a.asset.Label
+}
+
+func (a *assetResolver) Ticker() string {
+	return a.asset.Ticker
+}
+
+func (a *assetResolver) Quantity() float64 {
+	return a.asset.Quantity
+}
+
+func (a *assetResolver) Score() float64 {
+	return float64(a.asset.Score)
+}
+
+func (a *assetResolver) PreviousValue() float64 {
+	return a.asset.PreviousValue
+}
+
+func (a *assetResolver) CurrentValue() float64 {
+	return a.asset.CurrentValue
+}
+
+func (a *assetResolver) ValueVariation() float64 {
+	return a.asset.ValueVariation
+}
+
+func (a *assetResolver) PercentageFromTotal() float64 {
+	return a.asset.PercentageFromTotal
+}
+
+func (a *assetResolver) FinalContribution() float64 {
+	return a.asset.FinalContribution
+}
+
+func (a *assetResolver) Include() bool {
+	return a.asset.Include
+}
+
+func (a *assetResolver) Drift() float64 {
+	return a.asset.Drift(a.group.group.CurrentTotal())
+}
+
+func (a *assetResolver) Performance() *performanceResolver {
+	return &performanceResolver{
+		previousValue: a.asset.PreviousValue,
+		currentValue:  a.asset.CurrentValue,
+	}
+}
+
+func (a *assetResolver) History(ctx context.Context) []*auditEntryResolver {
+	res := []*auditEntryResolver{}
+	for _, e := range a.group.history.load(ctx, historyFilter{}) {
+		if e.AssetId == a.asset.Id {
+			res = append(res, &auditEntryResolver{entry: e})
+		}
+	}
+
+	return res
+}
+
+func (p *performanceResolver) PreviousValue() float64 {
+	return p.previousValue
+}
+
+func (p *performanceResolver) CurrentValue() float64 {
+	return p.currentValue
+}
+
+func (p *performanceResolver) Variation() float64 {
+	if p.previousValue == 0 {
+		return 0
+	}
+	return (p.currentValue - p.previousValue) / p.previousValue
+}
+
+func (e *auditEntryResolver) Id() graphql.ID {
+	return graphql.ID(e.entry.Id.String())
+}
+
+func (e *auditEntryResolver) AssetId() *graphql.ID {
+	if e.entry.AssetId == uuid.Nil {
+		return nil
+	}
+	id := graphql.ID(e.entry.AssetId.String())
+	return &id
+}
+
+func (e *auditEntryResolver) Actor() string {
+	return e.entry.Actor
+}
+
+func (e *auditEntryResolver) Principal() string {
+	return e.entry.Principal
+}
+
+func (e *auditEntryResolver) Operation() string {
+	return e.entry.Operation
+}
+
+func (e *auditEntryResolver) OccurredAt() graphql.Time {
+	return graphql.Time{Time: e.entry.OccurredAt}
+}
+
+func (e *auditEntryResolver) Changes() []*fieldChangeResolver {
+	res := []*fieldChangeResolver{}
+	for _, c := range e.entry.Changes {
+		res = append(res, &fieldChangeResolver{change: c})
+	}
+
+	return res
+}
+
+func (c *fieldChangeResolver) Field() string {
+	return c.change.Field
+}
+
+func (c *fieldChangeResolver) Before() *string {
+	return jsonValue(c.change.Before)
+}
+
+func (c *fieldChangeResolver) After() *string {
+	return jsonValue(c.change.After)
+}
+
+func (b *batchResultResolver) Applied() bool {
+	return b.result.Applied
+}
+
+func (b *batchResultResolver) Results() []*batchOperationResultResolver {
+	res := []*batchOperationResultResolver{}
+	for _, r := range b.result.Results {
+		res = append(res, &batchOperationResultResolver{result: r})
+	}
+
+	return res
+}
+
+func (b *batchResultResolver) Group() *groupResolver {
+	return b.group
+}
+
+func (o *batchOperationResultResolver) Index() int32 {
+	return int32(o.result.Index)
+}
+
+func (o *batchOperationResultResolver) Op() string {
+	return o.result.Op
+}
+
+func (o *batchOperationResultResolver) AssetId() *graphql.ID {
+	if o.result.AssetId == uuid.Nil {
+		return nil
+	}
+	id := graphql.ID(o.result.AssetId.String())
+	return &id
+}
+
+func (o *batchOperationResultResolver) Error() *string {
+	if o.result.Error == "" {
+		return nil
+	}
+	return &o.result.Error
+}
+
+func jsonValue(v interface{}) *string {
+	if v == nil {
+		return nil
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil
+	}
+	s := string(b)
+	return &s
+}
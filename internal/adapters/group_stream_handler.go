@@ -0,0 +1,100 @@
+package adapters
+
+import (
+	"io"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+)
+
+const groupStreamEvent = "group"
+
+type (
+	GroupStreamHandler struct {
+		useCase   ports.AssetBalancerUseCase
+		broker    ports.GroupUpdateBroker
+		heartbeat time.Duration
+		done      chan struct{}
+		closeOnce sync.Once
+	}
+)
+
+// HandleStreamAssetsGroup sends the group as a Server-Sent Event, then
+// again after every change, until the client goes away or the handler is
+// closed. A comment is sent every heartbeat so proxies keep the
+// connection open.
+func (h *GroupStreamHandler) HandleStreamAssetsGroup(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newErrorResult(err.Error()))
+		return
+	}
+
+	updates, err := h.broker.Subscribe(c.Request.Context(), id)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusServiceUnavailable, newErrorResult(err.Error()))
+		return
+	}
+	group := h.useCase.GetAssetsGroup(c, &boundaries.GetAssetsGroupInput{
+		Id: id,
+	})
+	if group == nil {
+		c.AbortWithStatusJSON(http.StatusPreconditionFailed, newErrorResult(domain.ASSETS_GROUP_NOT_FOUND))
+		return
+	}
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("X-Accel-Buffering", "no")
+	c.SSEvent(groupStreamEvent, group)
+	c.Writer.Flush()
+
+	heartbeat := time.NewTicker(h.heartbeat)
+	defer heartbeat.Stop()
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case g, ok := <-updates:
+			if !ok {
+				return false
+			}
+			c.SSEvent(groupStreamEvent, g)
+		case <-heartbeat.C:
+			io.WriteString(w, ":\n\n")
+		case <-h.done:
+			return false
+		}
+		return true
+	})
+}
+
+// Close ends every open stream, letting the server shut down without
+// waiting for clients to disconnect.
+func (h *GroupStreamHandler) Close() {
+	h.closeOnce.Do(func() {
+		close(h.done)
+	})
+}
+
+func NewGroupStreamHandler(
+	cfg *viper.Viper,
+	uc ports.AssetBalancerUseCase,
+	broker ports.GroupUpdateBroker) *GroupStreamHandler {
+	heartbeat := cfg.GetDuration("streams.heartbeat")
+	if heartbeat <= 0 {
+		heartbeat = 15 * time.Second
+	}
+
+	return &GroupStreamHandler{
+		useCase:   uc,
+		broker:    broker,
+		heartbeat: heartbeat,
+		done:      make(chan struct{}),
+	}
+}
@@ -0,0 +1,81 @@
+package adapters
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func readGroupEvent(t *testing.T, r *bufio.Reader) *domain.AssetsGroup {
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatal(err)
+		}
+		if line = strings.TrimSpace(line); strings.HasPrefix(line, "data:") {
+			group := &domain.AssetsGroup{}
+			if err := json.Unmarshal([]byte(strings.TrimPrefix(line, "data:")), group); err != nil {
+				t.Fatal(err)
+			}
+			return group
+		}
+	}
+}
+
+func Test_Should_StreamGroupUpdatesOverSse(t *testing.T) {
+	assert := assert.New(t)
+	group := domain.NewAssetGroup("test", []*domain.Asset{}, 100)
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		return group
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {}
+	broker := NewInProcessGroupUpdateBroker()
+	s := NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, NewChangeJournal(&mockedTransactor{},
+		newMockedInsertingRepository[*domain.DomainEvent](), newMockedInsertingRepository[*domain.AuditEntry](), broker))
+	h := NewGroupStreamHandler(viper.New(), s, broker)
+	eng := gin.New()
+	eng.GET("/v1/assetsGroup/:id/stream", h.HandleStreamAssetsGroup)
+	srv := httptest.NewServer(eng)
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+"/v1/assetsGroup/"+group.Id.String()+"/stream", nil)
+	res, err := http.DefaultClient.Do(req)
+	if !assert.Nil(err) {
+		t.FailNow()
+	}
+	defer res.Body.Close()
+	body := bufio.NewReader(res.Body)
+
+	first := readGroupEvent(t, body)
+	broker.Publish(ctx, domain.NewAssetGroup("other", []*domain.Asset{}, 0))
+	// saves that raise no domain event are streamed too
+	_, err = s.UpdateAssetsGroup(ctx, &boundaries.UpdateAssetsGroup{
+		Id:       group.Id,
+		TaxAware: ptr(true),
+	})
+	if !assert.Nil(err) {
+		t.FailNow()
+	}
+	second := readGroupEvent(t, body)
+
+	assert.Equal(http.StatusOK, res.StatusCode)
+	assert.Equal("text/event-stream", res.Header.Get("Content-Type"))
+	assert.False(first.TaxAware)
+	assert.True(second.TaxAware)
+	assert.Equal(group.Id, second.Id)
+}
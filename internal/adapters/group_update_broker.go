@@ -0,0 +1,72 @@
+package adapters
+
+import (
+	"context"
+	"sync"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+)
+
+type (
+	// InProcessGroupUpdateBroker reaches the subscribers of this instance
+	// only. A subscriber that falls behind gets the latest state and
+	// misses the ones in between.
+	InProcessGroupUpdateBroker struct {
+		mu          sync.Mutex
+		subscribers map[uuid.UUID][]chan *domain.AssetsGroup
+	}
+)
+
+// NewInProcessGroupUpdateBroker is published to by the ChangeJournal,
+// with every group it saved.
+func NewInProcessGroupUpdateBroker() ports.GroupUpdateBroker {
+	return &InProcessGroupUpdateBroker{
+		subscribers: map[uuid.UUID][]chan *domain.AssetsGroup{},
+	}
+}
+
+func (b *InProcessGroupUpdateBroker) Publish(ctx context.Context, group *domain.AssetsGroup) error {
+	update := group.Clone()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	for _, ch := range b.subscribers[group.Id] {
+		select {
+		case <-ch:
+		default:
+		}
+		ch <- update
+	}
+
+	return nil
+}
+
+func (b *InProcessGroupUpdateBroker) Subscribe(
+	ctx context.Context, groupId uuid.UUID) (<-chan *domain.AssetsGroup, error) {
+	ch := make(chan *domain.AssetsGroup, 1)
+	b.mu.Lock()
+	b.subscribers[groupId] = append(b.subscribers[groupId], ch)
+	b.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		b.mu.Lock()
+		defer b.mu.Unlock()
+		subs := b.subscribers[groupId]
+		if i := slices.Index(subs, ch); i >= 0 {
+			subs = slices.Delete(subs, i, i+1)
+		}
+		if len(subs) == 0 {
+			delete(b.subscribers, groupId)
+		} else {
+			b.subscribers[groupId] = subs
+		}
+		close(ch)
+	}()
+
+	return ch, nil
+}
@@ -0,0 +1,135 @@
+package adapters
+
+import (
+	"context"
+	"net/http"
+	"runtime"
+	"runtime/debug"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+)
+
+// Version is set at build time with
+// -ldflags "-X github.com/romaopatrick/assets-balancer/internal/adapters.Version=1.2.3".
+var Version = "dev"
+
+const (
+	HEALTH_STATUS_UP       = "UP"
+	HEALTH_STATUS_DOWN     = "DOWN"
+	HEALTH_STATUS_DRAINING = "DRAINING"
+)
+
+type (
+	HealthHandler struct {
+		checks    []ports.HealthCheck
+		timeout   time.Duration
+		build     *BuildInfo
+		startedAt time.Time
+		draining  atomic.Bool
+	}
+	BuildInfo struct {
+		Version   string
+		Revision  string `json:",omitempty"`
+		GoVersion string
+	}
+	HealthResult struct {
+		Status string
+		Build  *BuildInfo
+		Uptime string
+		Checks map[string]string `json:",omitempty"`
+	}
+)
+
+// HandleLiveness answers 200 while the process can serve requests at
+// all; it checks no dependency, so an outage doesn't get it restarted.
+func (h *HealthHandler) HandleLiveness(c *gin.Context) {
+	c.JSON(http.StatusOK, h.result(HEALTH_STATUS_UP, nil))
+}
+
+// HandleReadiness answers 503 while draining or when a dependency
+// check fails, so the orchestrator stops routing traffic here.
+func (h *HealthHandler) HandleReadiness(c *gin.Context) {
+	if h.draining.Load() {
+		c.JSON(http.StatusServiceUnavailable, h.result(HEALTH_STATUS_DRAINING, nil))
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c, h.timeout)
+	defer cancel()
+	checks := make(map[string]string, len(h.checks))
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	for _, check := range h.checks {
+		wg.Add(1)
+		go func(check ports.HealthCheck) {
+			defer wg.Done()
+			status := HEALTH_STATUS_UP
+			if err := check.Check(ctx); err != nil {
+				status = HEALTH_STATUS_DOWN + ": " + err.Error()
+			}
+			mu.Lock()
+			checks[check.Name()] = status
+			mu.Unlock()
+		}(check)
+	}
+	wg.Wait()
+
+	for _, status := range checks {
+		if status != HEALTH_STATUS_UP {
+			c.JSON(http.StatusServiceUnavailable, h.result(HEALTH_STATUS_DOWN, checks))
+			return
+		}
+	}
+	c.JSON(http.StatusOK, h.result(HEALTH_STATUS_UP, checks))
+}
+
+// Drain makes readiness fail from now on. The server calls it when
+// shutdown starts, ahead of closing its listener.
+func (h *HealthHandler) Drain() {
+	h.draining.Store(true)
+}
+
+func (h *HealthHandler) result(status string, checks map[string]string) *HealthResult {
+	return &HealthResult{
+		Status: status,
+		Build:  h.build,
+		Uptime: time.Since(h.startedAt).Round(time.Second).String(),
+		Checks: checks,
+	}
+}
+
+func NewHealthHandler(cfg *viper.Viper, checks []ports.HealthCheck) *HealthHandler {
+	timeout := cfg.GetDuration("health.timeout")
+	if timeout <= 0 {
+		timeout = 2 * time.Second
+	}
+
+	return &HealthHandler{
+		checks:    checks,
+		timeout:   timeout,
+		build:     readBuildInfo(),
+		startedAt: time.Now(),
+	}
+}
+
+func readBuildInfo() *BuildInfo {
+	b := &BuildInfo{
+		Version:   Version,
+		GoVersion: runtime.Version(),
+	}
+	if info, ok := debug.ReadBuildInfo(); ok {
+		for _, s := range info.Settings {
+			if s.Key == "vcs.revision" {
+				b.Revision = s.Value
+			}
+		}
+	}
+
+	return b
+}
@@ -0,0 +1,74 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	mockedHealthCheck struct {
+		name string
+		err  error
+	}
+)
+
+func serveHealth(h *HealthHandler, path string) (int, *HealthResult) {
+	eng := gin.New()
+	eng.GET("/healthz", h.HandleLiveness)
+	eng.GET("/readyz", h.HandleReadiness)
+	w := httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodGet, path, nil))
+	res := &HealthResult{}
+	json.Unmarshal(w.Body.Bytes(), res)
+
+	return w.Code, res
+}
+
+func Test_Should_FailReadinessWhenADependencyIsDown(t *testing.T) {
+	assert := assert.New(t)
+	h := NewHealthHandler(viper.New(), []ports.HealthCheck{
+		&mockedHealthCheck{name: "mongodb", err: errors.New("connection refused")},
+		&mockedHealthCheck{name: "cache"},
+	})
+
+	code, res := serveHealth(h, "/readyz")
+	liveCode, live := serveHealth(h, "/healthz")
+
+	assert.Equal(http.StatusServiceUnavailable, code)
+	assert.Equal(HEALTH_STATUS_DOWN, res.Status)
+	assert.Equal("DOWN: connection refused", res.Checks["mongodb"])
+	assert.Equal(HEALTH_STATUS_UP, res.Checks["cache"])
+	assert.Equal(http.StatusOK, liveCode)
+	assert.Equal(Version, live.Build.Version)
+}
+
+func Test_Should_FailReadinessWhileDraining(t *testing.T) {
+	assert := assert.New(t)
+	h := NewHealthHandler(viper.New(), []ports.HealthCheck{&mockedHealthCheck{name: "mongodb"}})
+
+	code, _ := serveHealth(h, "/readyz")
+	h.Drain()
+	drainingCode, res := serveHealth(h, "/readyz")
+
+	assert.Equal(http.StatusOK, code)
+	assert.Equal(http.StatusServiceUnavailable, drainingCode)
+	assert.Equal(HEALTH_STATUS_DRAINING, res.Status)
+}
+
+func (m *mockedHealthCheck) Name() string {
+	return m.name
+}
+
+func (m *mockedHealthCheck) Check(ctx context.Context) error {
+	return m.err
+}
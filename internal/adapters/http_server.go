@@ -0,0 +1,138 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/gin-contrib/cors"
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+	"golang.org/x/exp/slog"
+)
+
+type (
+	HttpServer struct {
+		server          *http.Server
+		certFile        string
+		keyFile         string
+		drainDelay      time.Duration
+		shutdownTimeout time.Duration
+		onDrain         []func()
+	}
+)
+
+// NewEngine allows cross-origin requests from "server.cors.allowOrigins"
+// only. Credentials are allowed for listed origins and never for "*".
+// Handlers pass the gin context on as a context.Context, so lookups fall
+// back to the request context, where the request span lives. Clients are
+// authenticated by their API key, then rate limited and request bodies
+// capped as set under "limits". CORS comes first, so the answers of the
+// limits carry its headers and preflights aren't limited.
+func NewEngine(cfg *viper.Viper, logger *slog.Logger) *gin.Engine {
+	eng := gin.New()
+	eng.ContextWithFallback = true
+	eng.Use(RequestIdMiddleware(), LoggingMiddleware(logger), RecoveryMiddleware(logger))
+	if origins := cfg.GetStringSlice("server.cors.allowOrigins"); len(origins) > 0 {
+		eng.Use(corsMiddleware(origins))
+	}
+	eng.Use(ApiKeyMiddleware(cfg), RateLimitMiddleware(cfg), BodySizeLimitMiddleware(cfg))
+
+	return eng
+}
+
+func corsMiddleware(origins []string) gin.HandlerFunc {
+	cc := cors.Config{
+		AllowMethods: []string{"GET", "POST", "PUT", "PATCH", "DELETE", "OPTIONS"},
+		AllowHeaders: []string{"Origin", "Content-Type", "Authorization",
+			actorHeader, apiKeyHeader, requestIdHeader, idempotencyKeyHeader},
+		ExposeHeaders: []string{"Content-Length", "Location", "Retry-After",
+			requestIdHeader, idempotentReplayedHeader},
+		MaxAge: 12 * time.Hour,
+	}
+	if len(origins) == 1 && origins[0] == "*" {
+		cc.AllowAllOrigins = true
+	} else {
+		cc.AllowOrigins = origins
+		cc.AllowCredentials = true
+	}
+
+	return cors.New(cc)
+}
+
+// NewHttpServer reads the "server" settings. A zero write timeout leaves
+// responses unbounded, which the group streams need. The drain delay is
+// how long to keep serving after readiness starts failing, giving load
+// balancers time to stop sending traffic.
+func NewHttpServer(cfg *viper.Viper, eng *gin.Engine) *HttpServer {
+	address := cfg.GetString("server.address")
+	if address == "" {
+		address = ":8081"
+	}
+	shutdownTimeout := cfg.GetDuration("server.shutdownTimeout")
+	if shutdownTimeout <= 0 {
+		shutdownTimeout = 30 * time.Second
+	}
+
+	return &HttpServer{
+		server: &http.Server{
+			Addr:         address,
+			Handler:      eng,
+			ReadTimeout:  cfg.GetDuration("server.readTimeout"),
+			WriteTimeout: cfg.GetDuration("server.writeTimeout"),
+			IdleTimeout:  cfg.GetDuration("server.idleTimeout"),
+		},
+		certFile:        cfg.GetString("server.tls.certFile"),
+		keyFile:         cfg.GetString("server.tls.keyFile"),
+		drainDelay:      cfg.GetDuration("server.drainDelay"),
+		shutdownTimeout: shutdownTimeout,
+	}
+}
+
+// OnDrain runs f as soon as ctx is done, before the drain delay.
+func (hs *HttpServer) OnDrain(f func()) {
+	hs.onDrain = append(hs.onDrain, f)
+}
+
+// OnShutdown runs f when shutdown starts, so long-lived responses can end
+// instead of holding it up.
+func (hs *HttpServer) OnShutdown(f func()) {
+	hs.server.RegisterOnShutdown(f)
+}
+
+// Start serves until ctx is done and the drain delay has passed, then
+// stops accepting connections and waits up to the shutdown timeout for
+// in-flight requests before closing the rest. TLS is used when both a
+// certificate and a key are configured.
+func (hs *HttpServer) Start(ctx context.Context) error {
+	stopped := make(chan error, 1)
+	go func() {
+		<-ctx.Done()
+		for _, f := range hs.onDrain {
+			f()
+		}
+		time.Sleep(hs.drainDelay)
+		sctx, cancel := context.WithTimeout(context.Background(), hs.shutdownTimeout)
+		defer cancel()
+		err := hs.server.Shutdown(sctx)
+		if err != nil {
+			hs.server.Close()
+		}
+		stopped <- err
+	}()
+
+	var err error
+	if hs.certFile != "" && hs.keyFile != "" {
+		slog.Info("http server listening", "address", hs.server.Addr, "tls", true)
+		err = hs.server.ListenAndServeTLS(hs.certFile, hs.keyFile)
+	} else {
+		slog.Info("http server listening", "address", hs.server.Addr, "tls", false)
+		err = hs.server.ListenAndServe()
+	}
+	if !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+
+	return <-stopped
+}
@@ -0,0 +1,111 @@
+package adapters
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slog"
+)
+
+func Test_Should_AllowCredentialsForConfiguredOriginsOnly(t *testing.T) {
+	assert := assert.New(t)
+	cfg := viper.New()
+	cfg.Set("server.cors.allowOrigins", []string{"https://app.example.com"})
+	eng := NewEngine(cfg, slog.Default())
+	eng.GET("/v1/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+
+	allowed := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/ping", nil)
+	req.Header.Set("Origin", "https://app.example.com")
+	eng.ServeHTTP(allowed, req)
+	denied := httptest.NewRecorder()
+	req = httptest.NewRequest(http.MethodGet, "/v1/ping", nil)
+	req.Header.Set("Origin", "https://evil.example.com")
+	eng.ServeHTTP(denied, req)
+
+	assert.Equal("https://app.example.com", allowed.Header().Get("Access-Control-Allow-Origin"))
+	assert.Equal("true", allowed.Header().Get("Access-Control-Allow-Credentials"))
+	assert.Equal(http.StatusForbidden, denied.Code)
+	assert.Empty(denied.Header().Get("Access-Control-Allow-Origin"))
+}
+
+func Test_Should_AnswerRateLimitedRequestsWithTheCorsHeaders(t *testing.T) {
+	assert := assert.New(t)
+	cfg := viper.New()
+	cfg.Set("server.cors.allowOrigins", []string{"https://app.example.com"})
+	cfg.Set("limits.rate.requestsPerSecond", 1)
+	cfg.Set("limits.rate.burst", 1)
+	eng := NewEngine(cfg, slog.Default())
+	eng.GET("/v1/ping", func(c *gin.Context) { c.Status(http.StatusOK) })
+	serve := func(method string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(method, "/v1/ping", nil)
+		req.Header.Set("Origin", "https://app.example.com")
+		req.Header.Set("Access-Control-Request-Method", http.MethodGet)
+		req.Header.Set("Access-Control-Request-Headers", apiKeyHeader)
+		eng.ServeHTTP(w, req)
+		return w
+	}
+
+	served := serve(http.MethodGet)
+	preflight := serve(http.MethodOptions)
+	limited := serve(http.MethodGet)
+
+	assert.Equal(http.StatusOK, served.Code)
+	assert.Equal(http.StatusNoContent, preflight.Code)
+	assert.Contains(preflight.Header().Get("Access-Control-Allow-Headers"), http.CanonicalHeaderKey(apiKeyHeader))
+	assert.Equal(http.StatusTooManyRequests, limited.Code)
+	assert.Equal("https://app.example.com", limited.Header().Get("Access-Control-Allow-Origin"))
+	assert.Contains(limited.Header().Get("Access-Control-Expose-Headers"), "Retry-After")
+}
+
+func Test_Should_DrainInFlightRequestsOnShutdown(t *testing.T) {
+	assert := assert.New(t)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if !assert.Nil(err) {
+		t.FailNow()
+	}
+	address := l.Addr().String()
+	l.Close()
+	cfg := viper.New()
+	cfg.Set("server.address", address)
+	started := make(chan struct{})
+	eng := NewEngine(cfg, slog.Default())
+	eng.GET("/v1/slow", func(c *gin.Context) {
+		close(started)
+		time.Sleep(100 * time.Millisecond)
+		c.Status(http.StatusOK)
+	})
+	hs := NewHttpServer(cfg, eng)
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan error, 1)
+	go func() {
+		stopped <- hs.Start(ctx)
+	}()
+
+	var res *http.Response
+	requested := make(chan error, 1)
+	go func() {
+		for i := 0; i < 50; i++ {
+			if res, err = http.Get("http://" + address + "/v1/slow"); err == nil {
+				break
+			}
+			time.Sleep(10 * time.Millisecond)
+		}
+		requested <- err
+	}()
+	<-started
+	cancel()
+
+	if !assert.Nil(<-requested) || !assert.Nil(<-stopped) {
+		t.FailNow()
+	}
+	assert.Equal(http.StatusOK, res.StatusCode)
+}
@@ -0,0 +1,200 @@
+package adapters
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	idempotencyKeyHeader      = "Idempotency-Key"
+	idempotentReplayedHeader  = "Idempotent-Replayed"
+	maxIdempotencyKeyLength   = 255
+	defaultIdempotencyKeysTtl = 24 * time.Hour
+	defaultIdempotencyLease   = time.Minute
+
+	INVALID_IDEMPOTENCY_KEY = "INVALID_IDEMPOTENCY_KEY"
+	IDEMPOTENCY_KEY_IN_USE  = "IDEMPOTENCY_KEY_IN_USE"
+	IDEMPOTENCY_KEY_REUSED  = "IDEMPOTENCY_KEY_REUSED"
+)
+
+type (
+	// IdempotencyHandler answers a retried request with the response to
+	// the first one sent with the same Idempotency-Key, for "idempotency.ttl".
+	// Keys belong to the principal that sent them, since the actor is
+	// named by callers themselves and can't keep them apart. The key is
+	// claimed while the first request is served for "idempotency.lease"
+	// only, so a claim left by an instance that stopped is given up soon.
+	IdempotencyHandler struct {
+		repository ports.Repository[*domain.IdempotencyRecord]
+		ttl        time.Duration
+		lease      time.Duration
+	}
+	idempotencyRecorder struct {
+		gin.ResponseWriter
+		body bytes.Buffer
+	}
+)
+
+func NewIdempotencyHandler(
+	cfg *viper.Viper,
+	repository ports.Repository[*domain.IdempotencyRecord]) *IdempotencyHandler {
+	ttl := cfg.GetDuration("idempotency.ttl")
+	if ttl <= 0 {
+		ttl = defaultIdempotencyKeysTtl
+	}
+	lease := cfg.GetDuration("idempotency.lease")
+	if lease <= 0 {
+		lease = defaultIdempotencyLease
+	}
+	return &IdempotencyHandler{
+		repository: repository,
+		ttl:        ttl,
+		lease:      lease,
+	}
+}
+
+// HandleIdempotencyKey goes before the handlers of the operations that
+// create something. Without the header the request is served as usual.
+// A retry with a different method, path or body is rejected, and so is
+// one sent while the first is still being served. Responses from 5xx
+// errors aren't kept, so the request can be retried.
+func (h *IdempotencyHandler) HandleIdempotencyKey(c *gin.Context) {
+	key := c.GetHeader(idempotencyKeyHeader)
+	if key == "" {
+		c.Next()
+		return
+	}
+	if len(key) > maxIdempotencyKeyLength {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newErrorResult(INVALID_IDEMPOTENCY_KEY))
+		return
+	}
+	body, err := io.ReadAll(c.Request.Body)
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusBadRequest, newErrorResult(err.Error()))
+		return
+	}
+	c.Request.Body = io.NopCloser(bytes.NewReader(body))
+
+	hash := requestHash(c.Request.Method, c.Request.URL.Path, body)
+	record, existing := h.claim(c, key, domain.PrincipalFromContext(c), hash)
+	if existing != nil {
+		h.answerExisting(c, existing, hash)
+		return
+	}
+
+	kept := false
+	defer func() {
+		if !kept {
+			h.repository.DeleteAll(c, map[string]interface{}{
+				"id": record.Id,
+			})
+		}
+	}()
+
+	w := &idempotencyRecorder{ResponseWriter: c.Writer}
+	c.Writer = w
+	c.Next()
+
+	if w.Status() >= http.StatusInternalServerError {
+		return
+	}
+	record.StatusCode = w.Status()
+	record.ContentType = w.Header().Get("Content-Type")
+	record.Location = w.Header().Get("Location")
+	record.Body = w.body.Bytes()
+	record.ExpiresAt = time.Now().Add(h.ttl)
+	h.repository.Replace(c, map[string]interface{}{
+		"id": record.Id,
+	}, record)
+	kept = true
+}
+
+// claim stores a pending record for the key, unless one that hasn't
+// expired is already stored, which is returned instead. Claims race on
+// the unique index of the key rather than on a lock: the one stored
+// first wins and the others are treated as in use.
+func (h *IdempotencyHandler) claim(
+	c *gin.Context,
+	key, principal, hash string) (record, existing *domain.IdempotencyRecord) {
+	existing = h.repository.GetFirst(c, map[string]interface{}{
+		"principal": principal,
+		"key":       key,
+	})
+	if existing != nil && !existing.IsExpired(time.Now()) {
+		return nil, existing
+	}
+	if existing != nil {
+		// by id, so a claim stored meanwhile by another request stays
+		h.repository.DeleteAll(c, map[string]interface{}{
+			"id": existing.Id,
+		})
+	}
+
+	defer func() {
+		if err := recover(); err != nil {
+			if e, ok := err.(error); ok && mongo.IsDuplicateKeyError(e) {
+				record, existing = nil, &domain.IdempotencyRecord{RequestHash: hash}
+				return
+			}
+			panic(err)
+		}
+	}()
+	record = domain.NewIdempotencyRecord(key, principal, hash, h.lease)
+	h.repository.Insert(c, record)
+
+	return record, nil
+}
+
+func (h *IdempotencyHandler) answerExisting(
+	c *gin.Context,
+	existing *domain.IdempotencyRecord,
+	hash string) {
+	if existing.RequestHash != hash {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newErrorResult(IDEMPOTENCY_KEY_REUSED))
+		return
+	}
+	if existing.IsPending() {
+		c.Header("Retry-After", "1")
+		c.AbortWithStatusJSON(http.StatusConflict, newErrorResult(IDEMPOTENCY_KEY_IN_USE))
+		return
+	}
+
+	c.Header(idempotentReplayedHeader, "true")
+	if existing.Location != "" {
+		c.Header("Location", existing.Location)
+	}
+	if len(existing.Body) == 0 {
+		c.AbortWithStatus(existing.StatusCode)
+		return
+	}
+	c.Data(existing.StatusCode, existing.ContentType, existing.Body)
+	c.Abort()
+}
+
+func requestHash(method, path string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(method + " " + path + "\n"))
+	h.Write(body)
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+func (w *idempotencyRecorder) Write(b []byte) (int, error) {
+	w.body.Write(b)
+	return w.ResponseWriter.Write(b)
+}
+
+func (w *idempotencyRecorder) WriteString(s string) (int, error) {
+	w.body.WriteString(s)
+	return w.ResponseWriter.WriteString(s)
+}
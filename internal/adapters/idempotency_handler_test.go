@@ -0,0 +1,160 @@
+package adapters
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func newMockedIdempotencyRepository() *mockedRepository[*domain.IdempotencyRecord] {
+	r := newMockedInsertingRepository[*domain.IdempotencyRecord]()
+	matches := func(e *domain.IdempotencyRecord, filter map[string]interface{}) bool {
+		if id, ok := filter["id"]; ok {
+			return e.Id == id
+		}
+		return e.Principal == filter["principal"] && e.Key == filter["key"]
+	}
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.IdempotencyRecord {
+		for _, e := range r.mockedDatabase {
+			if matches(e, filter) {
+				return e
+			}
+		}
+		return nil
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.IdempotencyRecord) {
+		for i, e := range r.mockedDatabase {
+			if matches(e, filter) {
+				r.mockedDatabase[i] = entity
+			}
+		}
+	}
+	r.mockDeleteAll = func(filter map[string]interface{}) int64 {
+		kept := []*domain.IdempotencyRecord{}
+		for _, e := range r.mockedDatabase {
+			if !matches(e, filter) {
+				kept = append(kept, e)
+			}
+		}
+		deleted := len(r.mockedDatabase) - len(kept)
+		r.mockedDatabase = kept
+		return int64(deleted)
+	}
+	return r
+}
+
+func newIdempotencyTestEngine(r *mockedRepository[*domain.IdempotencyRecord], status int, served *int) *gin.Engine {
+	ih := NewIdempotencyHandler(viper.New(), r)
+	cfg := viper.New()
+	cfg.Set("security.apiKeys", []map[string]interface{}{
+		{"key": "alice-key", "principal": "alice"},
+		{"key": "bob-key", "principal": "bob"},
+	})
+	eng := gin.New()
+	eng.ContextWithFallback = true
+	eng.Use(ActorMiddleware(), ApiKeyMiddleware(cfg))
+	eng.POST("/v2/groups", ih.HandleIdempotencyKey, func(c *gin.Context) {
+		*served++
+		c.Header("Location", "/v2/groups/1")
+		c.JSON(status, gin.H{"Served": *served})
+	})
+	return eng
+}
+
+// serveIdempotent sends body as principal, and as the same actor
+// whatever the principal.
+func serveIdempotent(eng *gin.Engine, key, principal, body string) *httptest.ResponseRecorder {
+	w := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodPost, "/v2/groups", strings.NewReader(body))
+	if key != "" {
+		req.Header.Set(idempotencyKeyHeader, key)
+	}
+	req.Header.Set(apiKeyHeader, principal+"-key")
+	req.Header.Set(actorHeader, "mallory")
+	eng.ServeHTTP(w, req)
+	return w
+}
+
+func Test_Should_ReplayTheFirstResponseForAnIdempotencyKey(t *testing.T) {
+	assert := assert.New(t)
+	r := newMockedIdempotencyRepository()
+	served := 0
+	eng := newIdempotencyTestEngine(r, http.StatusCreated, &served)
+
+	first := serveIdempotent(eng, "k1", "alice", `{"Label":"a"}`)
+	retry := serveIdempotent(eng, "k1", "alice", `{"Label":"a"}`)
+	reused := serveIdempotent(eng, "k1", "alice", `{"Label":"b"}`)
+	otherPrincipal := serveIdempotent(eng, "k1", "bob", `{"Label":"a"}`)
+	withoutKey := serveIdempotent(eng, "", "alice", `{"Label":"a"}`)
+
+	assert.Equal(3, served)
+	assert.Equal(http.StatusCreated, first.Code)
+	assert.Equal(http.StatusCreated, retry.Code)
+	assert.Equal(first.Body.String(), retry.Body.String())
+	assert.Equal("/v2/groups/1", retry.Header().Get("Location"))
+	assert.Equal("true", retry.Header().Get(idempotentReplayedHeader))
+	assert.Empty(first.Header().Get(idempotentReplayedHeader))
+	assert.Equal(http.StatusUnprocessableEntity, reused.Code)
+	assert.Contains(reused.Body.String(), IDEMPOTENCY_KEY_REUSED)
+	assert.Equal(`{"Served":2}`, otherPrincipal.Body.String())
+	assert.Equal(`{"Served":3}`, withoutKey.Body.String())
+}
+
+func Test_Should_NotKeepServerErrorsForAnIdempotencyKey(t *testing.T) {
+	assert := assert.New(t)
+	r := newMockedIdempotencyRepository()
+	served := 0
+	eng := newIdempotencyTestEngine(r, http.StatusServiceUnavailable, &served)
+
+	serveIdempotent(eng, "k1", "alice", `{}`)
+	retry := serveIdempotent(eng, "k1", "alice", `{}`)
+
+	assert.Equal(2, served)
+	assert.Equal(`{"Served":2}`, retry.Body.String())
+	assert.Empty(r.mockedDatabase)
+}
+
+func Test_Should_RejectAnIdempotencyKeyStillInUse(t *testing.T) {
+	assert := assert.New(t)
+	r := newMockedIdempotencyRepository()
+	r.mockedDatabase = append(r.mockedDatabase,
+		domain.NewIdempotencyRecord("k1", "alice", requestHash(http.MethodPost, "/v2/groups", []byte(`{}`)), defaultIdempotencyLease))
+	served := 0
+	eng := newIdempotencyTestEngine(r, http.StatusCreated, &served)
+
+	w := serveIdempotent(eng, "k1", "alice", `{}`)
+
+	assert.Equal(0, served)
+	assert.Equal(http.StatusConflict, w.Code)
+	assert.Equal("1", w.Header().Get("Retry-After"))
+}
+
+func Test_Should_LeasePendingClaimsAndKeepResponsesForTheTtl(t *testing.T) {
+	assert := assert.New(t)
+	r := newMockedIdempotencyRepository()
+	hash := requestHash(http.MethodPost, "/v2/groups", []byte(`{}`))
+	abandoned := domain.NewIdempotencyRecord("k1", "alice", hash, defaultIdempotencyLease)
+	abandoned.ExpiresAt = time.Now().Add(-time.Second)
+	r.mockedDatabase = append(r.mockedDatabase, abandoned)
+	served := 0
+	eng := newIdempotencyTestEngine(r, http.StatusCreated, &served)
+
+	w := serveIdempotent(eng, "k1", "alice", `{}`)
+
+	assert.Equal(1, served)
+	assert.Equal(http.StatusCreated, w.Code)
+	if !assert.Len(r.mockedDatabase, 1) {
+		t.FailNow()
+	}
+	kept := r.mockedDatabase[0]
+	assert.NotEqual(abandoned.Id, kept.Id)
+	assert.WithinDuration(time.Now().Add(defaultIdempotencyKeysTtl), kept.ExpiresAt, time.Minute)
+}
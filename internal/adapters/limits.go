@@ -0,0 +1,147 @@
+package adapters
+
+import (
+	"bytes"
+	"io"
+	"math"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+	"golang.org/x/time/rate"
+)
+
+const (
+	TOO_MANY_REQUESTS = "TOO_MANY_REQUESTS"
+	BODY_TOO_LARGE    = "BODY_TOO_LARGE"
+)
+
+type (
+	// clientRateLimiter keeps a token bucket per client. Buckets idle for
+	// longer than the expiry are dropped.
+	clientRateLimiter struct {
+		mu        sync.Mutex
+		clients   map[string]*clientBucket
+		limit     rate.Limit
+		burst     int
+		expiry    time.Duration
+		lastSweep time.Time
+		now       func() time.Time
+	}
+	clientBucket struct {
+		limiter  *rate.Limiter
+		lastSeen time.Time
+	}
+)
+
+// RateLimitMiddleware allows each client "limits.rate.requestsPerSecond"
+// requests per second, in bursts of up to "limits.rate.burst". Clients
+// are told apart by the principal their API key authenticates, or their
+// IP without a valid one, so it runs after ApiKeyMiddleware.
+// Requests over the limit get 429 with a Retry-After header. Probes and
+// scrapes aren't limited. A zero rate disables the limit.
+func RateLimitMiddleware(cfg *viper.Viper) gin.HandlerFunc {
+	rps := cfg.GetFloat64("limits.rate.requestsPerSecond")
+	if rps <= 0 {
+		return func(c *gin.Context) {
+			c.Next()
+		}
+	}
+	burst := cfg.GetInt("limits.rate.burst")
+	if burst <= 0 {
+		burst = int(math.Ceil(rps))
+	}
+	rl := &clientRateLimiter{
+		clients:   map[string]*clientBucket{},
+		limit:     rate.Limit(rps),
+		burst:     burst,
+		expiry:    10 * time.Minute,
+		lastSweep: time.Now(),
+		now:       time.Now,
+	}
+
+	return func(c *gin.Context) {
+		switch c.Request.URL.Path {
+		case "/healthz", "/readyz", "/metrics":
+			c.Next()
+			return
+		}
+		if wait, ok := rl.allow(rateLimitKey(c)); !ok {
+			c.Header("Retry-After", strconv.Itoa(int(math.Ceil(wait.Seconds()))))
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, newErrorResult(TOO_MANY_REQUESTS))
+			return
+		}
+		c.Next()
+	}
+}
+
+// BodySizeLimitMiddleware answers 413 to requests whose body is larger
+// than "limits.maxBodyBytes", reading at most that much of it. Zero
+// disables the limit.
+func BodySizeLimitMiddleware(cfg *viper.Viper) gin.HandlerFunc {
+	max := cfg.GetInt64("limits.maxBodyBytes")
+
+	return func(c *gin.Context) {
+		if max <= 0 || c.Request.Body == nil || c.Request.Body == http.NoBody {
+			c.Next()
+			return
+		}
+		if c.Request.ContentLength > max {
+			c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, newErrorResult(BODY_TOO_LARGE))
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(c.Request.Body, max+1))
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusBadRequest, newErrorResult(err.Error()))
+			return
+		}
+		if int64(len(body)) > max {
+			c.AbortWithStatusJSON(http.StatusRequestEntityTooLarge, newErrorResult(BODY_TOO_LARGE))
+			return
+		}
+		c.Request.Body = io.NopCloser(bytes.NewReader(body))
+		c.Next()
+	}
+}
+
+func rateLimitKey(c *gin.Context) string {
+	if principal := domain.PrincipalFromContext(c.Request.Context()); principal != domain.ANONYMOUS_PRINCIPAL {
+		return "principal:" + principal
+	}
+	return "ip:" + c.ClientIP()
+}
+
+// allow takes a token from the bucket of key, or tells how long until
+// one is available.
+func (rl *clientRateLimiter) allow(key string) (time.Duration, bool) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+
+	now := rl.now()
+	if now.Sub(rl.lastSweep) > rl.expiry {
+		for k, b := range rl.clients {
+			if now.Sub(b.lastSeen) > rl.expiry {
+				delete(rl.clients, k)
+			}
+		}
+		rl.lastSweep = now
+	}
+	b, ok := rl.clients[key]
+	if !ok {
+		b = &clientBucket{limiter: rate.NewLimiter(rl.limit, rl.burst)}
+		rl.clients[key] = b
+	}
+	b.lastSeen = now
+
+	r := b.limiter.ReserveN(now, 1)
+	if delay := r.DelayFrom(now); delay > 0 {
+		r.CancelAt(now)
+		return delay, false
+	}
+	return 0, true
+}
@@ -0,0 +1,85 @@
+package adapters
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Should_RateLimitEachClient(t *testing.T) {
+	assert := assert.New(t)
+	cfg := viper.New()
+	cfg.Set("limits.rate.requestsPerSecond", 1)
+	cfg.Set("limits.rate.burst", 2)
+	cfg.Set("security.apiKeys", []map[string]interface{}{
+		{"key": "first-key", "principal": "first"},
+		{"key": "second-key", "principal": "second"},
+	})
+	eng := gin.New()
+	eng.Use(ApiKeyMiddleware(cfg), RateLimitMiddleware(cfg))
+	eng.GET("/v1/assetsGroup", func(c *gin.Context) { c.Status(http.StatusOK) })
+	eng.GET("/healthz", func(c *gin.Context) { c.Status(http.StatusOK) })
+	serve := func(path, apiKey string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodGet, path, nil)
+		req.Header.Set(apiKeyHeader, apiKey)
+		eng.ServeHTTP(w, req)
+		return w
+	}
+
+	codes := []int{}
+	for i := 0; i < 3; i++ {
+		codes = append(codes, serve("/v1/assetsGroup", "first-key").Code)
+	}
+	limited := serve("/v1/assetsGroup", "first-key")
+	other := serve("/v1/assetsGroup", "second-key")
+	probe := serve("/healthz", "first-key")
+	// keys that aren't valid don't get a bucket of their own
+	anonymous := serve("/v1/assetsGroup", "made-up").Code
+	anonymousAgain := serve("/v1/assetsGroup", "").Code
+	invalid := serve("/v1/assetsGroup", "made-up-too")
+
+	assert.Equal([]int{http.StatusOK, http.StatusOK, http.StatusTooManyRequests}, codes)
+	assert.Equal("1", limited.Header().Get("Retry-After"))
+	assert.Equal(http.StatusOK, other.Code)
+	assert.Equal(http.StatusOK, probe.Code)
+	assert.Equal(http.StatusOK, anonymous)
+	assert.Equal(http.StatusOK, anonymousAgain)
+	assert.Equal(http.StatusTooManyRequests, invalid.Code)
+}
+
+func Test_Should_RejectBodiesOverTheLimit(t *testing.T) {
+	assert := assert.New(t)
+	cfg := viper.New()
+	cfg.Set("limits.maxBodyBytes", 16)
+	eng := gin.New()
+	eng.Use(BodySizeLimitMiddleware(cfg))
+	eng.POST("/v1/assetsGroup", func(c *gin.Context) {
+		body, _ := io.ReadAll(c.Request.Body)
+		c.String(http.StatusOK, string(body))
+	})
+	serve := func(body string, chunked bool) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		req := httptest.NewRequest(http.MethodPost, "/v1/assetsGroup", strings.NewReader(body))
+		if chunked {
+			req.ContentLength = -1
+		}
+		eng.ServeHTTP(w, req)
+		return w
+	}
+
+	small := serve(`{"Label":"a"}`, false)
+	large := serve(`{"Label":"abcdefghijklmnop"}`, false)
+	largeChunked := serve(`{"Label":"abcdefghijklmnop"}`, true)
+
+	assert.Equal(http.StatusOK, small.Code)
+	assert.Equal(`{"Label":"a"}`, small.Body.String())
+	assert.Equal(http.StatusRequestEntityTooLarge, large.Code)
+	assert.Equal(http.StatusRequestEntityTooLarge, largeChunked.Code)
+}
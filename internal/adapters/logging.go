@@ -0,0 +1,149 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/exp/slog"
+)
+
+const requestIdHeader = "X-Request-ID"
+
+type (
+	// contextHandler adds the request id and trace of the context to every
+	// record, so a line can be matched with its request and spans.
+	contextHandler struct {
+		slog.Handler
+	}
+)
+
+// NewLogger builds the logger from "logging.format" ("json", the default,
+// or "text") and "logging.level", and makes it the default one, which the
+// log package writes through too.
+func NewLogger(cfg *viper.Viper) *slog.Logger {
+	level := slog.LevelInfo
+	if l := cfg.GetString("logging.level"); l != "" {
+		if err := level.UnmarshalText([]byte(l)); err != nil {
+			panic(fmt.Sprintf("unknown logging level %q", l))
+		}
+	}
+	opts := slog.HandlerOptions{
+		Level:     level,
+		AddSource: cfg.GetBool("logging.addSource"),
+	}
+
+	var h slog.Handler
+	switch format := strings.ToLower(cfg.GetString("logging.format")); format {
+	case "", "json":
+		h = opts.NewJSONHandler(os.Stdout)
+	case "text":
+		h = opts.NewTextHandler(os.Stdout)
+	default:
+		panic(fmt.Sprintf("unknown logging format %q", format))
+	}
+
+	logger := slog.New(&contextHandler{Handler: h})
+	slog.SetDefault(logger)
+
+	return logger
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if ctx != nil {
+		if id := domain.RequestIdFromContext(ctx); id != "" {
+			r.AddAttrs(slog.String("request_id", id))
+		}
+		if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+			r.AddAttrs(
+				slog.String("trace_id", sc.TraceID().String()),
+				slog.String("span_id", sc.SpanID().String()))
+		}
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
+
+// RequestIdMiddleware takes the request id from the X-Request-ID header,
+// or makes one up, puts it on the context and echoes it in the response.
+func RequestIdMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(requestIdHeader)
+		if id == "" || len(id) > 128 {
+			id = uuid.NewString()
+		}
+		c.Request = c.Request.WithContext(domain.WithRequestId(c.Request.Context(), id))
+		c.Header(requestIdHeader, id)
+		c.Next()
+	}
+}
+
+// LoggingMiddleware writes one record per request once it's served, at
+// error level for 5xx responses and warn level for 4xx ones.
+func LoggingMiddleware(logger *slog.Logger) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		status := c.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+		attrs := []slog.Attr{
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.Request.URL.Path),
+			slog.String("route", c.FullPath()),
+			slog.Int("status", status),
+			slog.Duration("latency", time.Since(start)),
+			slog.String("client_ip", c.ClientIP()),
+			slog.String("actor", domain.ActorFromContext(c)),
+		}
+		if errs := c.Errors.ByType(gin.ErrorTypeAny).String(); errs != "" {
+			attrs = append(attrs, slog.String("errors", errs))
+		}
+		logger.LogAttrs(c, level, "request served", attrs...)
+	}
+}
+
+// RecoveryMiddleware logs a panic with the request it broke and answers
+// 500, instead of letting it vanish into gin's plain-text recovery.
+func RecoveryMiddleware(logger *slog.Logger) gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(nil, func(c *gin.Context, err any) {
+		logger.ErrorCtx(c, "request panicked",
+			slog.Any("error", err),
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.Request.URL.Path))
+		c.AbortWithStatusJSON(http.StatusInternalServerError, newErrorResult(http.StatusText(http.StatusInternalServerError)))
+	})
+}
+
+// logMutation logs how a use case changing state went, the ones rejected
+// or failed included. args tells what was changed.
+func logMutation(ctx context.Context, operation string, err error, args ...any) {
+	args = append([]any{"operation", operation, "actor", domain.ActorFromContext(ctx)}, args...)
+	if err != nil {
+		slog.WarnCtx(ctx, "mutation failed", append(args, "error", err)...)
+		return
+	}
+	slog.InfoCtx(ctx, "mutation applied", args...)
+}
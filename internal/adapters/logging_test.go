@@ -0,0 +1,132 @@
+package adapters
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"golang.org/x/exp/slog"
+)
+
+func newLoggingTestEngine(buf *bytes.Buffer) *gin.Engine {
+	logger := slog.New(&contextHandler{Handler: slog.NewJSONHandler(buf)})
+	eng := gin.New()
+	eng.ContextWithFallback = true
+	eng.Use(RequestIdMiddleware(), LoggingMiddleware(logger), RecoveryMiddleware(logger))
+	eng.GET("/v1/ok", func(c *gin.Context) {
+		logger.InfoCtx(c, "handled")
+		c.Status(http.StatusOK)
+	})
+	eng.GET("/v1/panic", func(c *gin.Context) {
+		panic("repository unavailable")
+	})
+
+	return eng
+}
+
+func readLogRecords(buf *bytes.Buffer) []map[string]interface{} {
+	records := []map[string]interface{}{}
+	for _, line := range strings.Split(strings.TrimSpace(buf.String()), "\n") {
+		r := map[string]interface{}{}
+		json.Unmarshal([]byte(line), &r)
+		records = append(records, r)
+	}
+
+	return records
+}
+
+func Test_Should_TagLogRecordsWithTheRequestId(t *testing.T) {
+	assert := assert.New(t)
+	buf := &bytes.Buffer{}
+	eng := newLoggingTestEngine(buf)
+
+	given := httptest.NewRecorder()
+	req := httptest.NewRequest(http.MethodGet, "/v1/ok", nil)
+	req.Header.Set(requestIdHeader, "abc-123")
+	eng.ServeHTTP(given, req)
+	generated := httptest.NewRecorder()
+	eng.ServeHTTP(generated, httptest.NewRequest(http.MethodGet, "/v1/ok", nil))
+
+	records := readLogRecords(buf)
+	if !assert.Len(records, 4) {
+		t.FailNow()
+	}
+	assert.Equal("abc-123", given.Header().Get(requestIdHeader))
+	assert.Equal("handled", records[0]["msg"])
+	assert.Equal("abc-123", records[0]["request_id"])
+	assert.Equal("request served", records[1]["msg"])
+	assert.Equal("abc-123", records[1]["request_id"])
+	assert.EqualValues(http.StatusOK, records[1]["status"])
+	assert.NotEmpty(generated.Header().Get(requestIdHeader))
+	assert.Equal(generated.Header().Get(requestIdHeader), records[3]["request_id"])
+}
+
+func Test_Should_LogPanicsAndAnswerInternalServerError(t *testing.T) {
+	assert := assert.New(t)
+	buf := &bytes.Buffer{}
+	eng := newLoggingTestEngine(buf)
+
+	w := httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/panic", nil))
+
+	records := readLogRecords(buf)
+	if !assert.Len(records, 2) {
+		t.FailNow()
+	}
+	assert.Equal(http.StatusInternalServerError, w.Code)
+	assert.Equal("request panicked", records[0]["msg"])
+	assert.Equal("repository unavailable", records[0]["error"])
+	assert.Equal(w.Header().Get(requestIdHeader), records[0]["request_id"])
+	assert.Equal("ERROR", records[1]["level"])
+}
+
+func Test_Should_LogAppliedAndRejectedMutations(t *testing.T) {
+	assert := assert.New(t)
+	buf := &bytes.Buffer{}
+	previous := slog.Default()
+	slog.SetDefault(slog.New(slog.NewJSONHandler(buf)))
+	t.Cleanup(func() { slog.SetDefault(previous) })
+	group := domain.NewAssetGroup("test", []*domain.Asset{
+		domain.NewAsset("RF", 100, 0, 100, 100, 0, true),
+	}, 100)
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		if filter["id"] == group.Id {
+			return group
+		}
+		return nil
+	}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {}
+	s := NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, newMockedChangeJournal())
+	ctx := domain.WithActor(context.Background(), "alice")
+
+	_, err := s.UpdateAssetsGroup(ctx, &boundaries.UpdateAssetsGroup{Id: group.Id, Label: ptr("renamed")})
+	assert.Nil(err)
+	missing := uuid.New()
+	_, err = s.UpdateAssetsGroup(ctx, &boundaries.UpdateAssetsGroup{Id: missing, Label: ptr("renamed")})
+	assert.ErrorIs(err, domain.ErrAssetsGroupNotFound)
+
+	records := readLogRecords(buf)
+	if !assert.Len(records, 2) {
+		t.FailNow()
+	}
+	assert.Equal("mutation applied", records[0]["msg"])
+	assert.Equal("UpdateAssetsGroup", records[0]["operation"])
+	assert.Equal("alice", records[0]["actor"])
+	assert.Equal(group.Id.String(), records[0]["group_id"])
+	assert.Equal("mutation failed", records[1]["msg"])
+	assert.Equal("WARN", records[1]["level"])
+	assert.Equal(missing.String(), records[1]["group_id"])
+	assert.Equal(domain.ASSETS_GROUP_NOT_FOUND, records[1]["error"])
+}
@@ -0,0 +1,130 @@
+package adapters
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"golang.org/x/exp/slog"
+)
+
+var (
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "assets_balancer",
+		Name:      "http_request_duration_seconds",
+		Help:      "HTTP request latency by route, method and status.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method", "status"})
+	repositoryOperationDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "assets_balancer",
+		Name:      "repository_operation_duration_seconds",
+		Help:      "Repository operation latency by collection and method.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"collection", "method"})
+	repositoryOperationErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "assets_balancer",
+		Name:      "repository_operation_errors_total",
+		Help:      "Failed repository operations by collection and method.",
+	}, []string{"collection", "method"})
+	inventoryCollectionErrors = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "assets_balancer",
+		Name:      "inventory_collection_errors_total",
+		Help:      "Scrapes that left the inventory gauges out.",
+	})
+	rebalanceDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "assets_balancer",
+		Name:      "rebalance_duration_seconds",
+		Help:      "Time spent rebalancing a group.",
+		Buckets:   prometheus.ExponentialBuckets(0.00001, 4, 10),
+	})
+	groupsDesc = prometheus.NewDesc("assets_balancer_groups",
+		"Number of assets groups.", nil, nil)
+	assetsDesc = prometheus.NewDesc("assets_balancer_assets",
+		"Number of assets across every group.", nil, nil)
+)
+
+type (
+	MetricsHandler struct {
+		handler gin.HandlerFunc
+	}
+	// inventoryCollector counts groups and assets at scrape time, so the
+	// gauges are right whichever instance made the changes.
+	inventoryCollector struct {
+		repository ports.Repository[*domain.AssetsGroup]
+		timeout    time.Duration
+	}
+)
+
+// MetricsMiddleware times every request. Requests matching no route are
+// labelled "unmatched" to keep the label set bounded.
+func MetricsMiddleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		route := c.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+		httpRequestDuration.
+			WithLabelValues(route, c.Request.Method, strconv.Itoa(c.Writer.Status())).
+			Observe(time.Since(start).Seconds())
+	}
+}
+
+func (h *MetricsHandler) HandleMetrics(c *gin.Context) {
+	h.handler(c)
+}
+
+func NewMetricsHandler(r ports.Repository[*domain.AssetsGroup]) *MetricsHandler {
+	registry := prometheus.NewRegistry()
+	registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestDuration,
+		repositoryOperationDuration,
+		repositoryOperationErrors,
+		inventoryCollectionErrors,
+		rebalanceDuration,
+		&inventoryCollector{repository: r, timeout: 5 * time.Second},
+	)
+
+	return &MetricsHandler{
+		handler: gin.WrapH(promhttp.HandlerFor(registry, promhttp.HandlerOpts{})),
+	}
+}
+
+func (ic *inventoryCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- groupsDesc
+	ch <- assetsDesc
+}
+
+// Collect leaves the gauges out of the scrape when the repository fails,
+// logging the failure and counting it in the inventory error metric.
+func (ic *inventoryCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), ic.timeout)
+	defer cancel()
+	defer func() {
+		if err := recover(); err != nil {
+			inventoryCollectionErrors.Inc()
+			slog.ErrorCtx(ctx, "inventory collection failed", "error", err)
+		}
+	}()
+
+	filter := notDeleted(map[string]interface{}{})
+	groups := ic.repository.Count(ctx, filter)
+	assets := ic.repository.CountElements(ctx, filter, "assets")
+	ch <- prometheus.MustNewConstMetric(groupsDesc, prometheus.GaugeValue, float64(groups))
+	ch <- prometheus.MustNewConstMetric(assetsDesc, prometheus.GaugeValue, float64(assets))
+}
+
+func observeRebalance(start time.Time) {
+	rebalanceDuration.Observe(time.Since(start).Seconds())
+}
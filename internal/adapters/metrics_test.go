@@ -0,0 +1,57 @@
+package adapters
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Should_ExposeRequestAndInventoryMetrics(t *testing.T) {
+	assert := assert.New(t)
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockCount = func(filter map[string]interface{}) int64 {
+		return 2
+	}
+	r.mockCountElements = func(filter map[string]interface{}, field string) int64 {
+		assert.Equal("assets", field)
+		return 5
+	}
+	eng := gin.New()
+	eng.Use(MetricsMiddleware())
+	eng.GET("/metrics", NewMetricsHandler(r).HandleMetrics)
+	eng.GET("/v2/groups/:groupId", func(c *gin.Context) { c.Status(http.StatusNotFound) })
+
+	eng.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v2/groups/1", nil))
+	w := httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.Contains(w.Body.String(),
+		`assets_balancer_http_request_duration_seconds_count{method="GET",route="/v2/groups/:groupId",status="404"}`)
+	assert.Contains(w.Body.String(), "assets_balancer_groups 2")
+	assert.Contains(w.Body.String(), "assets_balancer_assets 5")
+}
+
+func Test_Should_CountFailedInventoryCollections(t *testing.T) {
+	assert := assert.New(t)
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockCount = func(filter map[string]interface{}) int64 {
+		panic("connection refused")
+	}
+	before := testutil.ToFloat64(inventoryCollectionErrors)
+	eng := gin.New()
+	eng.GET("/metrics", NewMetricsHandler(r).HandleMetrics)
+
+	w := httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	assert.Equal(http.StatusOK, w.Code)
+	assert.NotContains(w.Body.String(), "assets_balancer_groups ")
+	assert.Equal(before+1, testutil.ToFloat64(inventoryCollectionErrors))
+}
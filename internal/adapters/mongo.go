@@ -2,11 +2,18 @@ package adapters
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
 	"github.com/spf13/viper"
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.mongodb.org/mongo-driver/mongo/readpref"
+	"golang.org/x/exp/slog"
 )
 
 func NewMongoClient(opts *options.ClientOptions) *mongo.Client {
@@ -32,3 +39,110 @@ func NewMongoDatabase(
 	db := cli.Database(ndb)
 	return db
 }
+
+// NewIdempotencyRepository makes sure a key is stored once per actor
+// and that Mongo drops the records once they expire. The records are
+// also checked for expiry when read, so the service still starts when
+// the indexes can't be created yet.
+func NewIdempotencyRepository(
+	db *mongo.Database) ports.Repository[*domain.IdempotencyRecord] {
+	r := &MongoDbRepository[*domain.IdempotencyRecord]{
+		collection: db.Collection("idempotencyrecord"),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	// keys used to belong to actors, whose index would now keep principals
+	// from using the same key
+	r.collection.Indexes().DropOne(ctx, "actor_1_key_1")
+	_, err := r.collection.Indexes().CreateMany(ctx, []mongo.IndexModel{
+		{
+			Keys:    bson.D{{Key: "principal", Value: 1}, {Key: "key", Value: 1}},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys:    bson.D{{Key: "expiresat", Value: 1}},
+			Options: options.Index().SetExpireAfterSeconds(0),
+		},
+	})
+	if err != nil {
+		slog.Warn("failed to create the idempotency indexes", "error", err)
+	}
+	return r
+}
+
+type MongoTransactor struct {
+	client  *mongo.Client
+	enabled bool
+}
+
+// NewMongoTransactor reads "mongodb.transactions", on unless set to
+// false. Transactions need a replica set; when they're disabled, work
+// runs without one and a group is no longer written atomically with its
+// outbox events and audit entries, which is only meant for development.
+func NewMongoTransactor(
+	cli *mongo.Client,
+	cfg *viper.Viper) ports.Transactor {
+	enabled := !cfg.IsSet("mongodb.transactions") || cfg.GetBool("mongodb.transactions")
+	if !enabled {
+		slog.Warn("mongodb transactions are disabled, groups aren't written atomically with their events")
+	}
+	return &MongoTransactor{
+		client:  cli,
+		enabled: enabled,
+	}
+}
+
+// WithinTransaction runs fn in a transaction, aborted when fn fails. The
+// repositories panic on failure, so a panic in fn is returned as its
+// error. Called within a transaction already, fn joins it.
+func (t *MongoTransactor) WithinTransaction(
+	ctx context.Context,
+	fn func(ctx context.Context) error) error {
+	if !t.enabled || mongo.SessionFromContext(ctx) != nil {
+		return recoverRepositoryPanic(ctx, fn)
+	}
+
+	session, err := t.client.StartSession()
+	if err != nil {
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sc mongo.SessionContext) (interface{}, error) {
+		return nil, recoverRepositoryPanic(sc, fn)
+	})
+	return err
+}
+
+func recoverRepositoryPanic(ctx context.Context, fn func(ctx context.Context) error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if rerr, ok := r.(error); ok {
+				err = rerr
+				return
+			}
+			err = fmt.Errorf("%v", r)
+		}
+	}()
+	return fn(ctx)
+}
+
+type MongoHealthCheck struct {
+	client *mongo.Client
+}
+
+// NewHealthChecks lists the dependencies /readyz checks.
+func NewHealthChecks(cli *mongo.Client) []ports.HealthCheck {
+	return []ports.HealthCheck{
+		&MongoHealthCheck{client: cli},
+	}
+}
+
+func (h *MongoHealthCheck) Name() string {
+	return "mongodb"
+}
+
+func (h *MongoHealthCheck) Check(ctx context.Context) error {
+	return h.client.Ping(ctx, readpref.Primary())
+}
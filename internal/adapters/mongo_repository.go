@@ -2,14 +2,21 @@ package adapters
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/romaopatrick/assets-balancer/internal/domain"
 	"github.com/romaopatrick/assets-balancer/internal/ports"
 
 	"reflect"
 	"strings"
+	"time"
 
+	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
 )
 
 type MongoDbRepository[T interface{}] struct {
@@ -29,6 +36,8 @@ func NewMongoDbRepository[T interface{}](
 func (r *MongoDbRepository[T]) GetAll(
 	ctx context.Context,
 	filter map[string]interface{}) []T {
+	ctx, end := r.startOperation(ctx, "GetAll")
+	defer end()
 
 	cur, err := r.collection.Find(ctx, filter)
 	if err != nil {
@@ -52,6 +61,8 @@ func (r *MongoDbRepository[T]) GetAllSkipTake(
 	filter map[string]interface{},
 	skip int64,
 	take int64) []T {
+	ctx, end := r.startOperation(ctx, "GetAllSkipTake")
+	defer end()
 
 	op := options.Find()
 	op.SetSkip(skip)
@@ -77,6 +88,8 @@ func (r *MongoDbRepository[T]) GetAllSkipTake(
 func (r *MongoDbRepository[T]) GetFirst(
 	ctx context.Context,
 	filter map[string]interface{}) T {
+	ctx, end := r.startOperation(ctx, "GetFirst")
+	defer end()
 	var el T
 	err := r.collection.FindOne(ctx, filter).Decode(&el)
 
@@ -91,9 +104,55 @@ func (r *MongoDbRepository[T]) GetFirst(
 	return el
 }
 
+func (r *MongoDbRepository[T]) Count(
+	ctx context.Context,
+	filter map[string]interface{}) int64 {
+	ctx, end := r.startOperation(ctx, "Count")
+	defer end()
+
+	n, err := r.collection.CountDocuments(ctx, filter)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (r *MongoDbRepository[T]) CountElements(
+	ctx context.Context,
+	filter map[string]interface{},
+	field string) int64 {
+	ctx, end := r.startOperation(ctx, "CountElements")
+	defer end()
+
+	cur, err := r.collection.Aggregate(ctx, []bson.M{
+		{"$match": filter},
+		{"$group": bson.M{
+			"_id": nil,
+			"n":   bson.M{"$sum": bson.M{"$size": bson.M{"$ifNull": bson.A{"$" + field, bson.A{}}}}},
+		}},
+	})
+	if err != nil {
+		panic(err)
+	}
+	defer cur.Close(ctx)
+
+	var res struct{ N int64 }
+	if cur.Next(ctx) {
+		if err = cur.Decode(&res); err != nil {
+			panic(err)
+		}
+	}
+	if err = cur.Err(); err != nil {
+		panic(err)
+	}
+	return res.N
+}
+
 func (r *MongoDbRepository[T]) Insert(
 	ctx context.Context,
 	entity T) {
+	ctx, end := r.startOperation(ctx, "Insert", entityAttributes(entity)...)
+	defer end()
 	_, err := r.collection.InsertOne(ctx, entity)
 	if err != nil {
 		panic(err)
@@ -103,19 +162,59 @@ func (r *MongoDbRepository[T]) Insert(
 func (r *MongoDbRepository[T]) Replace(
 	ctx context.Context,
 	filter map[string]interface{},
-	entity T) {
+	entity T) bool {
+	ctx, end := r.startOperation(ctx, "Replace", entityAttributes(entity)...)
+	defer end()
 
-	_, err := r.collection.ReplaceOne(ctx, filter, entity)
+	res, err := r.collection.ReplaceOne(ctx, filter, entity)
 	if err != nil {
 		panic(err)
 	}
+	return res.MatchedCount > 0
 }
 
 func (r *MongoDbRepository[T]) DeleteAll(
 	ctx context.Context,
-	filter map[string]interface{}) {
-	_, err := r.collection.DeleteMany(ctx, filter)
+	filter map[string]interface{}) int64 {
+	ctx, end := r.startOperation(ctx, "DeleteAll")
+	defer end()
+	res, err := r.collection.DeleteMany(ctx, filter)
 	if err != nil {
 		panic(err)
 	}
+	return res.DeletedCount
+}
+
+// startOperation opens a span for a repository call and returns the func
+// the caller defers to end it. The methods panic on failure, so a panic
+// is recorded as an error on the span and metrics, then passed on.
+func (r *MongoDbRepository[T]) startOperation(
+	ctx context.Context,
+	method string,
+	attrs ...attribute.KeyValue) (context.Context, func()) {
+	collection := r.collection.Name()
+	start := time.Now()
+	ctx, span := startSpan(ctx, "repository."+collection+"."+method, append(attrs,
+		semconv.DBSystemMongoDB,
+		semconv.DBMongoDBCollection(collection),
+		semconv.DBOperation(method))...)
+
+	return ctx, func() {
+		repositoryOperationDuration.WithLabelValues(collection, method).Observe(time.Since(start).Seconds())
+		if err := recover(); err != nil {
+			repositoryOperationErrors.WithLabelValues(collection, method).Inc()
+			span.RecordError(fmt.Errorf("%v", err))
+			span.SetStatus(codes.Error, fmt.Sprint(err))
+			span.End()
+			panic(err)
+		}
+		span.End()
+	}
+}
+
+func entityAttributes(entity interface{}) []attribute.KeyValue {
+	if group, ok := entity.(*domain.AssetsGroup); ok && group != nil {
+		return groupAttributes(group)
+	}
+	return nil
 }
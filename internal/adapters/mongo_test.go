@@ -0,0 +1,35 @@
+package adapters
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Should_EnableTransactionsUnlessDisabled(t *testing.T) {
+	assert := assert.New(t)
+	cfg := viper.New()
+
+	assert.True(NewMongoTransactor(nil, cfg).(*MongoTransactor).enabled)
+	cfg.Set("mongodb.transactions", false)
+	assert.False(NewMongoTransactor(nil, cfg).(*MongoTransactor).enabled)
+}
+
+func Test_Should_ReturnRepositoryPanicsAsErrors(t *testing.T) {
+	assert := assert.New(t)
+	failure := errors.New("connection reset")
+	tr := &MongoTransactor{}
+
+	err := tr.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		panic(failure)
+	})
+	assert.Equal(failure, err)
+
+	err = tr.WithinTransaction(context.Background(), func(ctx context.Context) error {
+		panic("no reachable servers")
+	})
+	assert.EqualError(err, "no reachable servers")
+}
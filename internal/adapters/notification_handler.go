@@ -0,0 +1,87 @@
+package adapters
+
+import (
+	"net/http"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type (
+	NotificationHandler struct {
+		useCase ports.NotificationUseCase
+	}
+)
+
+func (h *NotificationHandler) HandleCreateSubscription(c *gin.Context) {
+	input := &boundaries.CreateNotificationSubscriptionInput{}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
+		return
+	}
+
+	res, err := h.useCase.CreateSubscription(c, input)
+
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusPreconditionFailed, newErrorResult(err.Error()))
+		return
+	}
+
+	c.JSON(http.StatusCreated, res)
+}
+
+func (h *NotificationHandler) HandleDeleteSubscription(c *gin.Context) {
+	input := &boundaries.DeleteNotificationSubscriptionInput{}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
+		return
+	}
+
+	err := h.useCase.DeleteSubscription(c, input)
+
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusPreconditionFailed, newErrorResult(err.Error()))
+		return
+	}
+
+	c.Status(http.StatusOK)
+}
+
+func (h *NotificationHandler) HandleGetSubscriptions(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newErrorResult(err.Error()))
+		return
+	}
+
+	res := h.useCase.GetSubscriptions(c, &boundaries.GetNotificationSubscriptionsInput{
+		GroupId: id,
+	})
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *NotificationHandler) HandleGetDeliveries(c *gin.Context) {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newErrorResult(err.Error()))
+		return
+	}
+
+	res := h.useCase.GetDeliveries(c, &boundaries.GetNotificationDeliveriesInput{
+		GroupId: id,
+	})
+
+	c.JSON(http.StatusOK, res)
+}
+
+func NewNotificationHandler(uc ports.NotificationUseCase) *NotificationHandler {
+	return &NotificationHandler{
+		useCase: uc,
+	}
+}
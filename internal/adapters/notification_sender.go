@@ -0,0 +1,148 @@
+package adapters
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/spf13/viper"
+)
+
+const (
+	webhookSignatureHeader = "X-Signature"
+	webhookEventHeader     = "X-Event"
+	webhookDeliveryHeader  = "X-Notification-Id"
+)
+
+type (
+	WebhookSender struct {
+		client *http.Client
+	}
+	SmtpSender struct {
+		address  string
+		from     string
+		username string
+		password string
+	}
+)
+
+var errWebhookTargetNotAllowed = errors.New("webhook target resolves to a private address")
+
+// NewWebhookSender only connects to public addresses, checked once the
+// target is resolved so it can't be pointed at internal services, unless
+// allowPrivateTargets is set.
+func NewWebhookSender(timeout time.Duration, allowPrivateTargets bool) *WebhookSender {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivateTargets {
+		dialer.Control = func(network, address string, c syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !isPublicIP(ip) {
+				return errWebhookTargetNotAllowed
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &WebhookSender{
+		client: &http.Client{Timeout: timeout, Transport: transport},
+	}
+}
+
+func isPublicIP(ip net.IP) bool {
+	return ip.IsGlobalUnicast() && !ip.IsPrivate() && !ip.IsLoopback() && !ip.IsLinkLocalUnicast()
+}
+
+// Send posts the notification as JSON. When the subscription has a
+// secret, the body is signed with HMAC-SHA256 and the hex digest is sent
+// as "X-Signature: sha256=<digest>".
+func (ws *WebhookSender) Send(
+	ctx context.Context, s *domain.NotificationSubscription, n *domain.Notification) error {
+	body, err := json.Marshal(n)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.Target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(webhookEventHeader, n.Event)
+	req.Header.Set(webhookDeliveryHeader, n.Id.String())
+	if s.Secret != "" {
+		req.Header.Set(webhookSignatureHeader, "sha256="+signPayload(s.Secret, body))
+	}
+
+	res, err := ws.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("webhook responded with status %d", res.StatusCode)
+	}
+
+	return nil
+}
+
+func signPayload(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func NewSmtpSender(cfg *viper.Viper) *SmtpSender {
+	return &SmtpSender{
+		address:  cfg.GetString("notifications.smtp.address"),
+		from:     cfg.GetString("notifications.smtp.from"),
+		username: cfg.GetString("notifications.smtp.username"),
+		password: cfg.GetString("notifications.smtp.password"),
+	}
+}
+
+// Send mails the notification to the subscription target, which may
+// hold several comma separated addresses.
+func (ss *SmtpSender) Send(
+	ctx context.Context, s *domain.NotificationSubscription, n *domain.Notification) error {
+	payload, err := json.MarshalIndent(n.Payload, "", "  ")
+	if err != nil {
+		return err
+	}
+	to := strings.Split(s.Target, ",")
+	for i := range to {
+		to[i] = strings.TrimSpace(to[i])
+	}
+
+	msg := &bytes.Buffer{}
+	fmt.Fprintf(msg, "From: %s\r\n", ss.from)
+	fmt.Fprintf(msg, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(msg, "Subject: [assets-balancer] %s on group %s\r\n", n.Event, n.GroupId)
+	fmt.Fprintf(msg, "Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	fmt.Fprintf(msg, "%s at %s\r\n\r\n%s\r\n", n.Event, n.OccurredAt.Format(time.RFC3339), payload)
+
+	var auth smtp.Auth
+	if ss.username != "" {
+		host, _, _ := net.SplitHostPort(ss.address)
+		auth = smtp.PlainAuth("", ss.username, ss.password, host)
+	}
+
+	return smtp.SendMail(ss.address, auth, ss.from, to, msg.Bytes())
+}
@@ -0,0 +1,112 @@
+package adapters
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/textproto"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Should_SendSignedWebhook(t *testing.T) {
+	assert := assert.New(t)
+	var signature, body string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		b, _ := io.ReadAll(r.Body)
+		body = string(b)
+		signature = r.Header.Get(webhookSignatureHeader)
+	}))
+	defer srv.Close()
+	s := &domain.NotificationSubscription{Target: srv.URL, Secret: "secret"}
+	n := domain.NewNotification(domain.NOTIFICATION_GROUP_MODIFIED, uuid.New(), nil, time.Now())
+
+	err := NewWebhookSender(time.Second, true).Send(context.Background(), s, n)
+
+	if !assert.Nil(err) ||
+		!assert.Equal("sha256="+signPayload("secret", []byte(body)), signature) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_Not_SendWebhooksToPrivateAddresses(t *testing.T) {
+	assert := assert.New(t)
+	served := false
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		served = true
+	}))
+	defer srv.Close()
+	s := &domain.NotificationSubscription{Target: srv.URL}
+	n := domain.NewNotification(domain.NOTIFICATION_GROUP_MODIFIED, uuid.New(), nil, time.Now())
+
+	err := NewWebhookSender(time.Second, false).Send(context.Background(), s, n)
+
+	assert.ErrorIs(err, errWebhookTargetNotAllowed)
+	assert.False(served)
+}
+
+func Test_Should_SendEmail(t *testing.T) {
+	assert := assert.New(t)
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	received := make(chan string, 1)
+	go serveSmtpStub(l, received)
+
+	cfg := viper.New()
+	cfg.Set("notifications.smtp.address", l.Addr().String())
+	cfg.Set("notifications.smtp.from", "balancer@localhost")
+	s := &domain.NotificationSubscription{Target: "a@localhost, b@localhost"}
+	n := domain.NewNotification(domain.NOTIFICATION_PLAN_GENERATED, uuid.New(), nil, time.Now())
+
+	err = NewSmtpSender(cfg).Send(context.Background(), s, n)
+
+	if !assert.Nil(err) {
+		t.FailNow()
+	}
+	msg := <-received
+	assert.Contains(msg, "To: a@localhost, b@localhost")
+	assert.Contains(msg, domain.NOTIFICATION_PLAN_GENERATED)
+}
+
+// serveSmtpStub accepts a single session and answers every command
+// successfully, handing the DATA section over to received.
+func serveSmtpStub(l net.Listener, received chan<- string) {
+	conn, err := l.Accept()
+	if err != nil {
+		return
+	}
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+	tp.PrintfLine("220 localhost")
+	for {
+		line, err := tp.ReadLine()
+		if err != nil {
+			return
+		}
+		switch cmd := strings.ToUpper(strings.Fields(line)[0]); cmd {
+		case "DATA":
+			tp.PrintfLine("354 go ahead")
+			b, _ := io.ReadAll(bufio.NewReader(tp.DotReader()))
+			received <- string(b)
+			tp.PrintfLine("250 ok")
+		case "QUIT":
+			tp.PrintfLine("221 bye")
+			return
+		default:
+			tp.PrintfLine("250 ok")
+		}
+	}
+}
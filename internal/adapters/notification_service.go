@@ -0,0 +1,290 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/spf13/viper"
+	"golang.org/x/exp/slog"
+)
+
+type (
+	NotificationService struct {
+		subscriptions ports.Repository[*domain.NotificationSubscription]
+		deliveries    ports.Repository[*domain.NotificationDelivery]
+		senders       map[string]ports.NotificationSender
+		pool          *NotificationDeliveryPool
+		maxAttempts   int
+		backoff       time.Duration
+		now           func() time.Time
+	}
+	// NotificationDeliveryPool runs the deliveries on a fixed number of
+	// workers for as long as the application does.
+	NotificationDeliveryPool struct {
+		queue   chan func(ctx context.Context)
+		workers int
+	}
+)
+
+func NewNotificationService(
+	cfg *viper.Viper,
+	subscriptions ports.Repository[*domain.NotificationSubscription],
+	deliveries ports.Repository[*domain.NotificationDelivery],
+	pool *NotificationDeliveryPool) *NotificationService {
+	maxAttempts := cfg.GetInt("notifications.maxAttempts")
+	if maxAttempts <= 0 {
+		maxAttempts = 1
+	}
+
+	return &NotificationService{
+		subscriptions: subscriptions,
+		deliveries:    deliveries,
+		senders: map[string]ports.NotificationSender{
+			domain.NOTIFICATION_CHANNEL_WEBHOOK: NewWebhookSender(cfg.GetDuration("notifications.webhook.timeout"),
+				cfg.GetBool("notifications.webhook.allowPrivateTargets")),
+			domain.NOTIFICATION_CHANNEL_EMAIL: NewSmtpSender(cfg),
+		},
+		pool:        pool,
+		maxAttempts: maxAttempts,
+		backoff:     cfg.GetDuration("notifications.backoff"),
+		now:         time.Now,
+	}
+}
+
+func (ns *NotificationService) CreateSubscription(
+	ctx context.Context, input *boundaries.CreateNotificationSubscriptionInput) (_ *domain.NotificationSubscription, err error) {
+	defer func() { logMutation(ctx, "CreateSubscription", err, "group_id", input.GroupId) }()
+	s, err := domain.NewNotificationSubscription(input.GroupId,
+		input.Channel, input.Target, input.Secret,
+		input.Events, input.DriftThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	ns.subscriptions.Insert(ctx, s)
+
+	return s, nil
+}
+
+func (ns *NotificationService) DeleteSubscription(
+	ctx context.Context, input *boundaries.DeleteNotificationSubscriptionInput) (err error) {
+	defer func() { logMutation(ctx, "DeleteSubscription", err, "subscription_id", input.Id) }()
+	s := ns.subscriptions.GetFirst(ctx, map[string]interface{}{
+		"id": input.Id,
+	})
+
+	if s == nil {
+		return domain.ErrSubscriptionNotFound
+	}
+
+	ns.subscriptions.DeleteAll(ctx, map[string]interface{}{
+		"id": input.Id,
+	})
+
+	return nil
+}
+
+func (ns *NotificationService) GetSubscriptions(
+	ctx context.Context, input *boundaries.GetNotificationSubscriptionsInput) []*domain.NotificationSubscription {
+	return ns.subscriptions.GetAll(ctx, map[string]interface{}{
+		"groupid": input.GroupId,
+	})
+}
+
+func (ns *NotificationService) GetDeliveries(
+	ctx context.Context, input *boundaries.GetNotificationDeliveriesInput) []*domain.NotificationDelivery {
+	return ns.deliveries.GetAll(ctx, map[string]interface{}{
+		"groupid": input.GroupId,
+	})
+}
+
+// Publish fans the notification out to the group subscriptions. A group
+// modification also raises an ASSET_DRIFTED notification for every
+// subscription whose drifted assets changed beyond its threshold. The
+// payload is marshaled before the deliveries are queued on the pool, so
+// callers are never held by slow or failing receivers and may go on
+// changing what they published.
+func (ns *NotificationService) Publish(ctx context.Context, n *domain.Notification) {
+	group, modified := n.Payload.(*domain.AssetsGroup)
+	modified = modified && n.Event == domain.NOTIFICATION_GROUP_MODIFIED
+
+	subscriptions := ns.subscriptions.GetAll(ctx, map[string]interface{}{
+		"groupid": n.GroupId,
+	})
+	if len(subscriptions) == 0 {
+		return
+	}
+	sent, err := marshalNotification(n)
+	if err != nil {
+		slog.ErrorCtx(ctx, "notification not sent", "event", n.Event, "group_id", n.GroupId, "error", err)
+		return
+	}
+
+	for _, s := range subscriptions {
+		if s.Wants(n.Event) {
+			ns.dispatch(ctx, s, sent)
+		}
+		if !modified || !s.Wants(domain.NOTIFICATION_ASSET_DRIFTED) {
+			continue
+		}
+		drifts := group.DriftedAssets(s.DriftThreshold)
+		if !s.TrackDrift(drifts) {
+			continue
+		}
+		ns.subscriptions.Replace(ctx, map[string]interface{}{
+			"id": s.Id,
+		}, s)
+		if len(drifts) == 0 {
+			continue
+		}
+		drift, err := marshalNotification(domain.NewNotification(
+			domain.NOTIFICATION_ASSET_DRIFTED, n.GroupId, drifts, ns.now()))
+		if err != nil {
+			slog.ErrorCtx(ctx, "notification not sent", "event", domain.NOTIFICATION_ASSET_DRIFTED,
+				"group_id", n.GroupId, "error", err)
+			continue
+		}
+		ns.dispatch(ctx, s, drift)
+	}
+}
+
+// dispatch queues the delivery on the pool, dropping it when the pool is
+// full.
+func (ns *NotificationService) dispatch(
+	ctx context.Context, s *domain.NotificationSubscription, n *domain.Notification) {
+	if !ns.pool.Submit(func(ctx context.Context) {
+		ns.deliver(ctx, s, n)
+	}) {
+		slog.ErrorCtx(ctx, "notification delivery dropped, the queue is full",
+			"event", n.Event, "target", s.Target)
+	}
+}
+
+// deliver sends the notification retrying with exponential backoff and
+// records the outcome in the delivery log. Retries stop when ctx is done.
+func (ns *NotificationService) deliver(
+	ctx context.Context, s *domain.NotificationSubscription, n *domain.Notification) *domain.NotificationDelivery {
+	d := domain.NewNotificationDelivery(s, n, ns.now())
+	sender, ok := ns.senders[s.Channel]
+	if !ok {
+		d.LastError = domain.INVALID_NOTIFICATION_CHANNEL
+	}
+
+	wait := ns.backoff
+retries:
+	for ok && d.Attempts < ns.maxAttempts {
+		if d.Attempts > 0 {
+			select {
+			case <-ctx.Done():
+				d.LastError = ctx.Err().Error()
+				break retries
+			case <-time.After(wait):
+			}
+			wait *= 2
+		}
+		d.Attempts++
+		err := sender.Send(ctx, s, n)
+		if err == nil {
+			d.Delivered = true
+			d.LastError = ""
+			break
+		}
+		d.LastError = err.Error()
+	}
+	d.FinishedAt = ns.now()
+
+	if !d.Delivered {
+		slog.ErrorCtx(ctx, "notification delivery failed",
+			"event", n.Event, "target", s.Target, "attempts", d.Attempts, "error", d.LastError)
+	}
+	if ctx.Err() != nil {
+		// the outcome is still logged while shutting down
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+	}
+	ns.deliveries.Insert(ctx, d)
+
+	return d
+}
+
+// marshalNotification copies the notification with its payload as JSON.
+func marshalNotification(n *domain.Notification) (*domain.Notification, error) {
+	payload, err := json.Marshal(n.Payload)
+	if err != nil {
+		return nil, err
+	}
+	sent := *n
+	sent.Payload = json.RawMessage(payload)
+
+	return &sent, nil
+}
+
+// NewNotificationDeliveryPool reads "notifications.workers", how many
+// deliveries run at once, and "notifications.queueSize", how many wait
+// for a worker before more are dropped.
+func NewNotificationDeliveryPool(cfg *viper.Viper) *NotificationDeliveryPool {
+	workers := cfg.GetInt("notifications.workers")
+	if workers <= 0 {
+		workers = 1
+	}
+
+	return &NotificationDeliveryPool{
+		queue:   make(chan func(ctx context.Context), cfg.GetInt("notifications.queueSize")),
+		workers: workers,
+	}
+}
+
+// Submit queues the delivery, telling whether there was room for it.
+func (p *NotificationDeliveryPool) Submit(delivery func(ctx context.Context)) bool {
+	select {
+	case p.queue <- delivery:
+		return true
+	default:
+		return false
+	}
+}
+
+// Start runs the workers until ctx is done. The deliveries under way see
+// ctx done and stop retrying; those still queued are dropped.
+func (p *NotificationDeliveryPool) Start(ctx context.Context) {
+	done := make(chan struct{})
+	for i := 0; i < p.workers; i++ {
+		go func() {
+			defer func() { done <- struct{}{} }()
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case delivery := <-p.queue:
+					p.run(ctx, delivery)
+				}
+			}
+		}()
+	}
+	for i := 0; i < p.workers; i++ {
+		<-done
+	}
+}
+
+// run keeps a delivery that panics, writing its log, from taking the
+// worker down.
+func (p *NotificationDeliveryPool) run(ctx context.Context, delivery func(ctx context.Context)) {
+	defer func() {
+		if err := recover(); err != nil {
+			slog.ErrorCtx(ctx, "notification delivery failed", "error", fmt.Sprint(err))
+		}
+	}()
+	delivery(ctx)
+}
+
+func publishGroupModified(ctx context.Context, publisher ports.NotificationPublisher, group *domain.AssetsGroup) {
+	publisher.Publish(ctx, domain.NewNotification(
+		domain.NOTIFICATION_GROUP_MODIFIED, group.Id, group, time.Now()))
+}
@@ -0,0 +1,155 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	mockedNotificationSender struct {
+		failures int
+		sent     int
+		payloads []json.RawMessage
+	}
+)
+
+func Test_Should_RetryNotificationDelivery(t *testing.T) {
+	assert := assert.New(t)
+	dr := newMockedRepository[*domain.NotificationDelivery]()
+	dr.mockInsert = func(e *domain.NotificationDelivery) {
+		dr.mockedDatabase = append(dr.mockedDatabase, e)
+	}
+	sender := &mockedNotificationSender{failures: 2}
+	s := &NotificationService{
+		deliveries:  dr,
+		senders:     map[string]ports.NotificationSender{domain.NOTIFICATION_CHANNEL_WEBHOOK: sender},
+		maxAttempts: 3,
+		backoff:     time.Millisecond,
+		now:         time.Now,
+	}
+	sub := &domain.NotificationSubscription{Id: uuid.New(), Channel: domain.NOTIFICATION_CHANNEL_WEBHOOK}
+	n := domain.NewNotification(domain.NOTIFICATION_GROUP_MODIFIED, uuid.New(), nil, time.Now())
+
+	d := s.deliver(context.Background(), sub, n)
+
+	if !assert.True(d.Delivered) ||
+		!assert.Equal(3, d.Attempts) ||
+		!assert.Equal(1, sender.sent) ||
+		!assert.Len(dr.mockedDatabase, 1) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_LogFailedNotificationDelivery(t *testing.T) {
+	assert := assert.New(t)
+	dr := newMockedRepository[*domain.NotificationDelivery]()
+	dr.mockInsert = func(e *domain.NotificationDelivery) {
+		dr.mockedDatabase = append(dr.mockedDatabase, e)
+	}
+	s := &NotificationService{
+		deliveries:  dr,
+		senders:     map[string]ports.NotificationSender{domain.NOTIFICATION_CHANNEL_WEBHOOK: &mockedNotificationSender{failures: 5}},
+		maxAttempts: 2,
+		now:         time.Now,
+	}
+	sub := &domain.NotificationSubscription{Id: uuid.New(), Channel: domain.NOTIFICATION_CHANNEL_WEBHOOK}
+	n := domain.NewNotification(domain.NOTIFICATION_GROUP_MODIFIED, uuid.New(), nil, time.Now())
+
+	d := s.deliver(context.Background(), sub, n)
+
+	if !assert.False(d.Delivered) ||
+		!assert.Equal(2, d.Attempts) ||
+		!assert.NotEmpty(d.LastError) ||
+		!assert.Len(dr.mockedDatabase, 1) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_QueueMarshaledNotificationsAndAlertOnDriftChanges(t *testing.T) {
+	assert := assert.New(t)
+	drifted := domain.NewAsset("drifted", 50, 100, 700, 1000, 0, true)
+	onTarget := domain.NewAsset("onTarget", 50, 100, 300, 1000, 0, true)
+	group := domain.NewAssetGroup("test", []*domain.Asset{drifted, onTarget}, 0)
+	sub, _ := domain.NewNotificationSubscription(group.Id, domain.NOTIFICATION_CHANNEL_WEBHOOK,
+		"https://example.com/hook", "", []string{domain.NOTIFICATION_GROUP_MODIFIED, domain.NOTIFICATION_ASSET_DRIFTED}, 5)
+	sr := newMockedRepository[*domain.NotificationSubscription]()
+	sr.mockGetAll = func(filter map[string]interface{}) []*domain.NotificationSubscription {
+		return []*domain.NotificationSubscription{sub}
+	}
+	saved := 0
+	sr.mockReplace = func(filter map[string]interface{}, entity *domain.NotificationSubscription) {
+		saved++
+	}
+	pool := &NotificationDeliveryPool{queue: make(chan func(ctx context.Context), 3), workers: 1}
+	s := &NotificationService{
+		subscriptions: sr,
+		pool:          pool,
+		now:           time.Now,
+	}
+
+	publishGroupModified(context.Background(), s, group)
+	publishGroupModified(context.Background(), s, group)
+	// a modification and a drift alert, then the modification alone
+	assert.Len(pool.queue, 3)
+	assert.Equal(1, saved)
+	// the queue is full, so the next deliveries are dropped
+	publishGroupModified(context.Background(), s, group)
+	assert.Len(pool.queue, 3)
+
+	sender := &mockedNotificationSender{}
+	s.senders = map[string]ports.NotificationSender{domain.NOTIFICATION_CHANNEL_WEBHOOK: sender}
+	s.deliveries = newMockedInsertingRepository[*domain.NotificationDelivery]()
+	s.maxAttempts = 1
+	group.Label = "changed after publishing"
+	for len(pool.queue) > 0 {
+		(<-pool.queue)(context.Background())
+	}
+	assert.Equal(3, sender.sent)
+	if assert.Len(sender.payloads, 3) {
+		assert.NotContains(string(sender.payloads[0]), "changed after publishing")
+		assert.Contains(string(sender.payloads[1]), drifted.Id.String())
+	}
+}
+
+func Test_Should_StopRetryingWhenThePoolStops(t *testing.T) {
+	assert := assert.New(t)
+	s := &NotificationService{
+		deliveries:  newMockedInsertingRepository[*domain.NotificationDelivery](),
+		senders:     map[string]ports.NotificationSender{domain.NOTIFICATION_CHANNEL_WEBHOOK: &mockedNotificationSender{failures: 5}},
+		maxAttempts: 5,
+		backoff:     time.Hour,
+		now:         time.Now,
+	}
+	sub := &domain.NotificationSubscription{Id: uuid.New(), Channel: domain.NOTIFICATION_CHANNEL_WEBHOOK}
+	n := domain.NewNotification(domain.NOTIFICATION_GROUP_MODIFIED, uuid.New(), nil, time.Now())
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	d := s.deliver(ctx, sub, n)
+
+	assert.False(d.Delivered)
+	assert.Equal(1, d.Attempts)
+	assert.Equal(context.Canceled.Error(), d.LastError)
+}
+
+func (ms *mockedNotificationSender) Send(
+	ctx context.Context, s *domain.NotificationSubscription, n *domain.Notification) error {
+	if ms.failures > 0 {
+		ms.failures--
+		return errors.New("unavailable")
+	}
+	ms.sent++
+	if payload, ok := n.Payload.(json.RawMessage); ok {
+		ms.payloads = append(ms.payloads, payload)
+	}
+	return nil
+}
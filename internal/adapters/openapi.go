@@ -0,0 +1,18 @@
+package adapters
+
+import (
+	_ "embed"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+)
+
+// openApiSpec documents every v1 and v2 route. Keep it in step with
+// ConfigureRouter, router_test checks that no route is missing.
+//
+//go:embed openapi.json
+var openApiSpec []byte
+
+func HandleGetOpenApiSpec(c *gin.Context) {
+	c.Data(http.StatusOK, "application/json", openApiSpec)
+}
@@ -0,0 +1,1272 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: assets_balancer.proto
+
+package pb
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	AssetsBalancer_GetAssetsGroups_FullMethodName        = "/assetsbalancer.v1.AssetsBalancer/GetAssetsGroups"
+	AssetsBalancer_GetAssetsGroup_FullMethodName         = "/assetsbalancer.v1.AssetsBalancer/GetAssetsGroup"
+	AssetsBalancer_CreateAssetsGroup_FullMethodName      = "/assetsbalancer.v1.AssetsBalancer/CreateAssetsGroup"
+	AssetsBalancer_UpdateAssetsGroup_FullMethodName      = "/assetsbalancer.v1.AssetsBalancer/UpdateAssetsGroup"
+	AssetsBalancer_DeleteAssetsGroup_FullMethodName      = "/assetsbalancer.v1.AssetsBalancer/DeleteAssetsGroup"
+	AssetsBalancer_CreateAsset_FullMethodName            = "/assetsbalancer.v1.AssetsBalancer/CreateAsset"
+	AssetsBalancer_UpdateAsset_FullMethodName            = "/assetsbalancer.v1.AssetsBalancer/UpdateAsset"
+	AssetsBalancer_DeleteAsset_FullMethodName            = "/assetsbalancer.v1.AssetsBalancer/DeleteAsset"
+	AssetsBalancer_ApplyBatch_FullMethodName             = "/assetsbalancer.v1.AssetsBalancer/ApplyBatch"
+	AssetsBalancer_CloneAssetsGroup_FullMethodName       = "/assetsbalancer.v1.AssetsBalancer/CloneAssetsGroup"
+	AssetsBalancer_GetDeletedAssetsGroups_FullMethodName = "/assetsbalancer.v1.AssetsBalancer/GetDeletedAssetsGroups"
+	AssetsBalancer_GetDeletedAssets_FullMethodName       = "/assetsbalancer.v1.AssetsBalancer/GetDeletedAssets"
+	AssetsBalancer_RestoreAssetsGroup_FullMethodName     = "/assetsbalancer.v1.AssetsBalancer/RestoreAssetsGroup"
+	AssetsBalancer_RestoreAsset_FullMethodName           = "/assetsbalancer.v1.AssetsBalancer/RestoreAsset"
+	AssetsBalancer_SetGlidePath_FullMethodName           = "/assetsbalancer.v1.AssetsBalancer/SetGlidePath"
+	AssetsBalancer_DeleteGlidePath_FullMethodName        = "/assetsbalancer.v1.AssetsBalancer/DeleteGlidePath"
+	AssetsBalancer_PreviewGlidePath_FullMethodName       = "/assetsbalancer.v1.AssetsBalancer/PreviewGlidePath"
+	AssetsBalancer_SetAssetConstraints_FullMethodName    = "/assetsbalancer.v1.AssetsBalancer/SetAssetConstraints"
+	AssetsBalancer_DeleteAssetConstraints_FullMethodName = "/assetsbalancer.v1.AssetsBalancer/DeleteAssetConstraints"
+	AssetsBalancer_SetTradingCosts_FullMethodName        = "/assetsbalancer.v1.AssetsBalancer/SetTradingCosts"
+	AssetsBalancer_DeleteTradingCosts_FullMethodName     = "/assetsbalancer.v1.AssetsBalancer/DeleteTradingCosts"
+	AssetsBalancer_AddTaxLot_FullMethodName              = "/assetsbalancer.v1.AssetsBalancer/AddTaxLot"
+	AssetsBalancer_DeleteTaxLot_FullMethodName           = "/assetsbalancer.v1.AssetsBalancer/DeleteTaxLot"
+	AssetsBalancer_WatchAssetsGroup_FullMethodName       = "/assetsbalancer.v1.AssetsBalancer/WatchAssetsGroup"
+)
+
+// AssetsBalancerClient is the client API for AssetsBalancer service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AssetsBalancerClient interface {
+	GetAssetsGroups(ctx context.Context, in *GetAssetsGroupsRequest, opts ...grpc.CallOption) (*GetAssetsGroupsResponse, error)
+	GetAssetsGroup(ctx context.Context, in *GetAssetsGroupRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+	CreateAssetsGroup(ctx context.Context, in *CreateAssetsGroupRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+	UpdateAssetsGroup(ctx context.Context, in *UpdateAssetsGroupRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+	DeleteAssetsGroup(ctx context.Context, in *DeleteAssetsGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateAsset(ctx context.Context, in *CreateAssetRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+	UpdateAsset(ctx context.Context, in *UpdateAssetRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+	DeleteAsset(ctx context.Context, in *DeleteAssetRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+	ApplyBatch(ctx context.Context, in *ApplyBatchRequest, opts ...grpc.CallOption) (*ApplyBatchResponse, error)
+	CloneAssetsGroup(ctx context.Context, in *CloneAssetsGroupRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+	// The trash holds the deleted groups, and the deleted assets of every
+	// group, until they are purged.
+	GetDeletedAssetsGroups(ctx context.Context, in *GetDeletedAssetsGroupsRequest, opts ...grpc.CallOption) (*GetAssetsGroupsResponse, error)
+	GetDeletedAssets(ctx context.Context, in *GetDeletedAssetsRequest, opts ...grpc.CallOption) (*GetDeletedAssetsResponse, error)
+	RestoreAssetsGroup(ctx context.Context, in *RestoreAssetsGroupRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+	RestoreAsset(ctx context.Context, in *RestoreAssetRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+	SetGlidePath(ctx context.Context, in *SetGlidePathRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+	DeleteGlidePath(ctx context.Context, in *DeleteGlidePathRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+	PreviewGlidePath(ctx context.Context, in *PreviewGlidePathRequest, opts ...grpc.CallOption) (*PreviewGlidePathResponse, error)
+	SetAssetConstraints(ctx context.Context, in *SetAssetConstraintsRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+	DeleteAssetConstraints(ctx context.Context, in *DeleteAssetConstraintsRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+	SetTradingCosts(ctx context.Context, in *SetTradingCostsRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+	DeleteTradingCosts(ctx context.Context, in *DeleteTradingCostsRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+	AddTaxLot(ctx context.Context, in *AddTaxLotRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+	DeleteTaxLot(ctx context.Context, in *DeleteTaxLotRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+	// WatchAssetsGroup sends the group, then the group again after every
+	// change, until the client cancels.
+	WatchAssetsGroup(ctx context.Context, in *WatchAssetsGroupRequest, opts ...grpc.CallOption) (AssetsBalancer_WatchAssetsGroupClient, error)
+}
+
+type assetsBalancerClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAssetsBalancerClient(cc grpc.ClientConnInterface) AssetsBalancerClient {
+	return &assetsBalancerClient{cc}
+}
+
+func (c *assetsBalancerClient) GetAssetsGroups(ctx context.Context, in *GetAssetsGroupsRequest, opts ...grpc.CallOption) (*GetAssetsGroupsResponse, error) {
+	out := new(GetAssetsGroupsResponse)
+	err := c.cc.Invoke(ctx, AssetsBalancer_GetAssetsGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) GetAssetsGroup(ctx context.Context, in *GetAssetsGroupRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, AssetsBalancer_GetAssetsGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) CreateAssetsGroup(ctx context.Context, in *CreateAssetsGroupRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, AssetsBalancer_CreateAssetsGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) UpdateAssetsGroup(ctx context.Context, in *UpdateAssetsGroupRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, AssetsBalancer_UpdateAssetsGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) DeleteAssetsGroup(ctx context.Context, in *DeleteAssetsGroupRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, AssetsBalancer_DeleteAssetsGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) CreateAsset(ctx context.Context, in *CreateAssetRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, AssetsBalancer_CreateAsset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) UpdateAsset(ctx context.Context, in *UpdateAssetRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, AssetsBalancer_UpdateAsset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) DeleteAsset(ctx context.Context, in *DeleteAssetRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, AssetsBalancer_DeleteAsset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) ApplyBatch(ctx context.Context, in *ApplyBatchRequest, opts ...grpc.CallOption) (*ApplyBatchResponse, error) {
+	out := new(ApplyBatchResponse)
+	err := c.cc.Invoke(ctx, AssetsBalancer_ApplyBatch_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) CloneAssetsGroup(ctx context.Context, in *CloneAssetsGroupRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, AssetsBalancer_CloneAssetsGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) GetDeletedAssetsGroups(ctx context.Context, in *GetDeletedAssetsGroupsRequest, opts ...grpc.CallOption) (*GetAssetsGroupsResponse, error) {
+	out := new(GetAssetsGroupsResponse)
+	err := c.cc.Invoke(ctx, AssetsBalancer_GetDeletedAssetsGroups_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) GetDeletedAssets(ctx context.Context, in *GetDeletedAssetsRequest, opts ...grpc.CallOption) (*GetDeletedAssetsResponse, error) {
+	out := new(GetDeletedAssetsResponse)
+	err := c.cc.Invoke(ctx, AssetsBalancer_GetDeletedAssets_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) RestoreAssetsGroup(ctx context.Context, in *RestoreAssetsGroupRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, AssetsBalancer_RestoreAssetsGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) RestoreAsset(ctx context.Context, in *RestoreAssetRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, AssetsBalancer_RestoreAsset_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) SetGlidePath(ctx context.Context, in *SetGlidePathRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, AssetsBalancer_SetGlidePath_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) DeleteGlidePath(ctx context.Context, in *DeleteGlidePathRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, AssetsBalancer_DeleteGlidePath_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) PreviewGlidePath(ctx context.Context, in *PreviewGlidePathRequest, opts ...grpc.CallOption) (*PreviewGlidePathResponse, error) {
+	out := new(PreviewGlidePathResponse)
+	err := c.cc.Invoke(ctx, AssetsBalancer_PreviewGlidePath_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) SetAssetConstraints(ctx context.Context, in *SetAssetConstraintsRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, AssetsBalancer_SetAssetConstraints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) DeleteAssetConstraints(ctx context.Context, in *DeleteAssetConstraintsRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, AssetsBalancer_DeleteAssetConstraints_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) SetTradingCosts(ctx context.Context, in *SetTradingCostsRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, AssetsBalancer_SetTradingCosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) DeleteTradingCosts(ctx context.Context, in *DeleteTradingCostsRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, AssetsBalancer_DeleteTradingCosts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) AddTaxLot(ctx context.Context, in *AddTaxLotRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, AssetsBalancer_AddTaxLot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) DeleteTaxLot(ctx context.Context, in *DeleteTaxLotRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, AssetsBalancer_DeleteTaxLot_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *assetsBalancerClient) WatchAssetsGroup(ctx context.Context, in *WatchAssetsGroupRequest, opts ...grpc.CallOption) (AssetsBalancer_WatchAssetsGroupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AssetsBalancer_ServiceDesc.Streams[0], AssetsBalancer_WatchAssetsGroup_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &assetsBalancerWatchAssetsGroupClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AssetsBalancer_WatchAssetsGroupClient interface {
+	Recv() (*AssetsGroup, error)
+	grpc.ClientStream
+}
+
+type assetsBalancerWatchAssetsGroupClient struct {
+	grpc.ClientStream
+}
+
+func (x *assetsBalancerWatchAssetsGroupClient) Recv() (*AssetsGroup, error) {
+	m := new(AssetsGroup)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AssetsBalancerServer is the server API for AssetsBalancer service.
+// All implementations must embed UnimplementedAssetsBalancerServer
+// for forward compatibility
+type AssetsBalancerServer interface {
+	GetAssetsGroups(context.Context, *GetAssetsGroupsRequest) (*GetAssetsGroupsResponse, error)
+	GetAssetsGroup(context.Context, *GetAssetsGroupRequest) (*AssetsGroup, error)
+	CreateAssetsGroup(context.Context, *CreateAssetsGroupRequest) (*AssetsGroup, error)
+	UpdateAssetsGroup(context.Context, *UpdateAssetsGroupRequest) (*AssetsGroup, error)
+	DeleteAssetsGroup(context.Context, *DeleteAssetsGroupRequest) (*emptypb.Empty, error)
+	CreateAsset(context.Context, *CreateAssetRequest) (*AssetsGroup, error)
+	UpdateAsset(context.Context, *UpdateAssetRequest) (*AssetsGroup, error)
+	DeleteAsset(context.Context, *DeleteAssetRequest) (*AssetsGroup, error)
+	ApplyBatch(context.Context, *ApplyBatchRequest) (*ApplyBatchResponse, error)
+	CloneAssetsGroup(context.Context, *CloneAssetsGroupRequest) (*AssetsGroup, error)
+	// The trash holds the deleted groups, and the deleted assets of every
+	// group, until they are purged.
+	GetDeletedAssetsGroups(context.Context, *GetDeletedAssetsGroupsRequest) (*GetAssetsGroupsResponse, error)
+	GetDeletedAssets(context.Context, *GetDeletedAssetsRequest) (*GetDeletedAssetsResponse, error)
+	RestoreAssetsGroup(context.Context, *RestoreAssetsGroupRequest) (*AssetsGroup, error)
+	RestoreAsset(context.Context, *RestoreAssetRequest) (*AssetsGroup, error)
+	SetGlidePath(context.Context, *SetGlidePathRequest) (*AssetsGroup, error)
+	DeleteGlidePath(context.Context, *DeleteGlidePathRequest) (*AssetsGroup, error)
+	PreviewGlidePath(context.Context, *PreviewGlidePathRequest) (*PreviewGlidePathResponse, error)
+	SetAssetConstraints(context.Context, *SetAssetConstraintsRequest) (*AssetsGroup, error)
+	DeleteAssetConstraints(context.Context, *DeleteAssetConstraintsRequest) (*AssetsGroup, error)
+	SetTradingCosts(context.Context, *SetTradingCostsRequest) (*AssetsGroup, error)
+	DeleteTradingCosts(context.Context, *DeleteTradingCostsRequest) (*AssetsGroup, error)
+	AddTaxLot(context.Context, *AddTaxLotRequest) (*AssetsGroup, error)
+	DeleteTaxLot(context.Context, *DeleteTaxLotRequest) (*AssetsGroup, error)
+	// WatchAssetsGroup sends the group, then the group again after every
+	// change, until the client cancels.
+	WatchAssetsGroup(*WatchAssetsGroupRequest, AssetsBalancer_WatchAssetsGroupServer) error
+	mustEmbedUnimplementedAssetsBalancerServer()
+}
+
+// UnimplementedAssetsBalancerServer must be embedded to have forward compatible implementations.
+type UnimplementedAssetsBalancerServer struct {
+}
+
+func (UnimplementedAssetsBalancerServer) GetAssetsGroups(context.Context, *GetAssetsGroupsRequest) (*GetAssetsGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssetsGroups not implemented")
+}
+func (UnimplementedAssetsBalancerServer) GetAssetsGroup(context.Context, *GetAssetsGroupRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssetsGroup not implemented")
+}
+func (UnimplementedAssetsBalancerServer) CreateAssetsGroup(context.Context, *CreateAssetsGroupRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAssetsGroup not implemented")
+}
+func (UnimplementedAssetsBalancerServer) UpdateAssetsGroup(context.Context, *UpdateAssetsGroupRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAssetsGroup not implemented")
+}
+func (UnimplementedAssetsBalancerServer) DeleteAssetsGroup(context.Context, *DeleteAssetsGroupRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssetsGroup not implemented")
+}
+func (UnimplementedAssetsBalancerServer) CreateAsset(context.Context, *CreateAssetRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAsset not implemented")
+}
+func (UnimplementedAssetsBalancerServer) UpdateAsset(context.Context, *UpdateAssetRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAsset not implemented")
+}
+func (UnimplementedAssetsBalancerServer) DeleteAsset(context.Context, *DeleteAssetRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAsset not implemented")
+}
+func (UnimplementedAssetsBalancerServer) ApplyBatch(context.Context, *ApplyBatchRequest) (*ApplyBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyBatch not implemented")
+}
+func (UnimplementedAssetsBalancerServer) CloneAssetsGroup(context.Context, *CloneAssetsGroupRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloneAssetsGroup not implemented")
+}
+func (UnimplementedAssetsBalancerServer) GetDeletedAssetsGroups(context.Context, *GetDeletedAssetsGroupsRequest) (*GetAssetsGroupsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedAssetsGroups not implemented")
+}
+func (UnimplementedAssetsBalancerServer) GetDeletedAssets(context.Context, *GetDeletedAssetsRequest) (*GetDeletedAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeletedAssets not implemented")
+}
+func (UnimplementedAssetsBalancerServer) RestoreAssetsGroup(context.Context, *RestoreAssetsGroupRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAssetsGroup not implemented")
+}
+func (UnimplementedAssetsBalancerServer) RestoreAsset(context.Context, *RestoreAssetRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreAsset not implemented")
+}
+func (UnimplementedAssetsBalancerServer) SetGlidePath(context.Context, *SetGlidePathRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetGlidePath not implemented")
+}
+func (UnimplementedAssetsBalancerServer) DeleteGlidePath(context.Context, *DeleteGlidePathRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteGlidePath not implemented")
+}
+func (UnimplementedAssetsBalancerServer) PreviewGlidePath(context.Context, *PreviewGlidePathRequest) (*PreviewGlidePathResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewGlidePath not implemented")
+}
+func (UnimplementedAssetsBalancerServer) SetAssetConstraints(context.Context, *SetAssetConstraintsRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAssetConstraints not implemented")
+}
+func (UnimplementedAssetsBalancerServer) DeleteAssetConstraints(context.Context, *DeleteAssetConstraintsRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAssetConstraints not implemented")
+}
+func (UnimplementedAssetsBalancerServer) SetTradingCosts(context.Context, *SetTradingCostsRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTradingCosts not implemented")
+}
+func (UnimplementedAssetsBalancerServer) DeleteTradingCosts(context.Context, *DeleteTradingCostsRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTradingCosts not implemented")
+}
+func (UnimplementedAssetsBalancerServer) AddTaxLot(context.Context, *AddTaxLotRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddTaxLot not implemented")
+}
+func (UnimplementedAssetsBalancerServer) DeleteTaxLot(context.Context, *DeleteTaxLotRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTaxLot not implemented")
+}
+func (UnimplementedAssetsBalancerServer) WatchAssetsGroup(*WatchAssetsGroupRequest, AssetsBalancer_WatchAssetsGroupServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchAssetsGroup not implemented")
+}
+func (UnimplementedAssetsBalancerServer) mustEmbedUnimplementedAssetsBalancerServer() {}
+
+// UnsafeAssetsBalancerServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AssetsBalancerServer will
+// result in compilation errors.
+type UnsafeAssetsBalancerServer interface {
+	mustEmbedUnimplementedAssetsBalancerServer()
+}
+
+func RegisterAssetsBalancerServer(s grpc.ServiceRegistrar, srv AssetsBalancerServer) {
+	s.RegisterService(&AssetsBalancer_ServiceDesc, srv)
+}
+
+func _AssetsBalancer_GetAssetsGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssetsGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).GetAssetsGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_GetAssetsGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).GetAssetsGroups(ctx, req.(*GetAssetsGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_GetAssetsGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssetsGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).GetAssetsGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_GetAssetsGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).GetAssetsGroup(ctx, req.(*GetAssetsGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_CreateAssetsGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssetsGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).CreateAssetsGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_CreateAssetsGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).CreateAssetsGroup(ctx, req.(*CreateAssetsGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_UpdateAssetsGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssetsGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).UpdateAssetsGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_UpdateAssetsGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).UpdateAssetsGroup(ctx, req.(*UpdateAssetsGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_DeleteAssetsGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssetsGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).DeleteAssetsGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_DeleteAssetsGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).DeleteAssetsGroup(ctx, req.(*DeleteAssetsGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_CreateAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).CreateAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_CreateAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).CreateAsset(ctx, req.(*CreateAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_UpdateAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).UpdateAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_UpdateAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).UpdateAsset(ctx, req.(*UpdateAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_DeleteAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).DeleteAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_DeleteAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).DeleteAsset(ctx, req.(*DeleteAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_ApplyBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyBatchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).ApplyBatch(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_ApplyBatch_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).ApplyBatch(ctx, req.(*ApplyBatchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_CloneAssetsGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneAssetsGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).CloneAssetsGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_CloneAssetsGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).CloneAssetsGroup(ctx, req.(*CloneAssetsGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_GetDeletedAssetsGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeletedAssetsGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).GetDeletedAssetsGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_GetDeletedAssetsGroups_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).GetDeletedAssetsGroups(ctx, req.(*GetDeletedAssetsGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_GetDeletedAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeletedAssetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).GetDeletedAssets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_GetDeletedAssets_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).GetDeletedAssets(ctx, req.(*GetDeletedAssetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_RestoreAssetsGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAssetsGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).RestoreAssetsGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_RestoreAssetsGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).RestoreAssetsGroup(ctx, req.(*RestoreAssetsGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_RestoreAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreAssetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).RestoreAsset(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_RestoreAsset_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).RestoreAsset(ctx, req.(*RestoreAssetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_SetGlidePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetGlidePathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).SetGlidePath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_SetGlidePath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).SetGlidePath(ctx, req.(*SetGlidePathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_DeleteGlidePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGlidePathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).DeleteGlidePath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_DeleteGlidePath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).DeleteGlidePath(ctx, req.(*DeleteGlidePathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_PreviewGlidePath_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewGlidePathRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).PreviewGlidePath(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_PreviewGlidePath_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).PreviewGlidePath(ctx, req.(*PreviewGlidePathRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_SetAssetConstraints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetAssetConstraintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).SetAssetConstraints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_SetAssetConstraints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).SetAssetConstraints(ctx, req.(*SetAssetConstraintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_DeleteAssetConstraints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAssetConstraintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).DeleteAssetConstraints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_DeleteAssetConstraints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).DeleteAssetConstraints(ctx, req.(*DeleteAssetConstraintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_SetTradingCosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTradingCostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).SetTradingCosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_SetTradingCosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).SetTradingCosts(ctx, req.(*SetTradingCostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_DeleteTradingCosts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTradingCostsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).DeleteTradingCosts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_DeleteTradingCosts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).DeleteTradingCosts(ctx, req.(*DeleteTradingCostsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_AddTaxLot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddTaxLotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).AddTaxLot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_AddTaxLot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).AddTaxLot(ctx, req.(*AddTaxLotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_DeleteTaxLot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTaxLotRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AssetsBalancerServer).DeleteTaxLot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AssetsBalancer_DeleteTaxLot_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AssetsBalancerServer).DeleteTaxLot(ctx, req.(*DeleteTaxLotRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AssetsBalancer_WatchAssetsGroup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchAssetsGroupRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AssetsBalancerServer).WatchAssetsGroup(m, &assetsBalancerWatchAssetsGroupServer{stream})
+}
+
+type AssetsBalancer_WatchAssetsGroupServer interface {
+	Send(*AssetsGroup) error
+	grpc.ServerStream
+}
+
+type assetsBalancerWatchAssetsGroupServer struct {
+	grpc.ServerStream
+}
+
+func (x *assetsBalancerWatchAssetsGroupServer) Send(m *AssetsGroup) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// AssetsBalancer_ServiceDesc is the grpc.ServiceDesc for AssetsBalancer service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AssetsBalancer_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "assetsbalancer.v1.AssetsBalancer",
+	HandlerType: (*AssetsBalancerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetAssetsGroups",
+			Handler:    _AssetsBalancer_GetAssetsGroups_Handler,
+		},
+		{
+			MethodName: "GetAssetsGroup",
+			Handler:    _AssetsBalancer_GetAssetsGroup_Handler,
+		},
+		{
+			MethodName: "CreateAssetsGroup",
+			Handler:    _AssetsBalancer_CreateAssetsGroup_Handler,
+		},
+		{
+			MethodName: "UpdateAssetsGroup",
+			Handler:    _AssetsBalancer_UpdateAssetsGroup_Handler,
+		},
+		{
+			MethodName: "DeleteAssetsGroup",
+			Handler:    _AssetsBalancer_DeleteAssetsGroup_Handler,
+		},
+		{
+			MethodName: "CreateAsset",
+			Handler:    _AssetsBalancer_CreateAsset_Handler,
+		},
+		{
+			MethodName: "UpdateAsset",
+			Handler:    _AssetsBalancer_UpdateAsset_Handler,
+		},
+		{
+			MethodName: "DeleteAsset",
+			Handler:    _AssetsBalancer_DeleteAsset_Handler,
+		},
+		{
+			MethodName: "ApplyBatch",
+			Handler:    _AssetsBalancer_ApplyBatch_Handler,
+		},
+		{
+			MethodName: "CloneAssetsGroup",
+			Handler:    _AssetsBalancer_CloneAssetsGroup_Handler,
+		},
+		{
+			MethodName: "GetDeletedAssetsGroups",
+			Handler:    _AssetsBalancer_GetDeletedAssetsGroups_Handler,
+		},
+		{
+			MethodName: "GetDeletedAssets",
+			Handler:    _AssetsBalancer_GetDeletedAssets_Handler,
+		},
+		{
+			MethodName: "RestoreAssetsGroup",
+			Handler:    _AssetsBalancer_RestoreAssetsGroup_Handler,
+		},
+		{
+			MethodName: "RestoreAsset",
+			Handler:    _AssetsBalancer_RestoreAsset_Handler,
+		},
+		{
+			MethodName: "SetGlidePath",
+			Handler:    _AssetsBalancer_SetGlidePath_Handler,
+		},
+		{
+			MethodName: "DeleteGlidePath",
+			Handler:    _AssetsBalancer_DeleteGlidePath_Handler,
+		},
+		{
+			MethodName: "PreviewGlidePath",
+			Handler:    _AssetsBalancer_PreviewGlidePath_Handler,
+		},
+		{
+			MethodName: "SetAssetConstraints",
+			Handler:    _AssetsBalancer_SetAssetConstraints_Handler,
+		},
+		{
+			MethodName: "DeleteAssetConstraints",
+			Handler:    _AssetsBalancer_DeleteAssetConstraints_Handler,
+		},
+		{
+			MethodName: "SetTradingCosts",
+			Handler:    _AssetsBalancer_SetTradingCosts_Handler,
+		},
+		{
+			MethodName: "DeleteTradingCosts",
+			Handler:    _AssetsBalancer_DeleteTradingCosts_Handler,
+		},
+		{
+			MethodName: "AddTaxLot",
+			Handler:    _AssetsBalancer_AddTaxLot_Handler,
+		},
+		{
+			MethodName: "DeleteTaxLot",
+			Handler:    _AssetsBalancer_DeleteTaxLot_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchAssetsGroup",
+			Handler:       _AssetsBalancer_WatchAssetsGroup_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "assets_balancer.proto",
+}
+
+const (
+	PortfolioTemplates_GetTemplates_FullMethodName        = "/assetsbalancer.v1.PortfolioTemplates/GetTemplates"
+	PortfolioTemplates_GetTemplate_FullMethodName         = "/assetsbalancer.v1.PortfolioTemplates/GetTemplate"
+	PortfolioTemplates_CreateTemplate_FullMethodName      = "/assetsbalancer.v1.PortfolioTemplates/CreateTemplate"
+	PortfolioTemplates_UpdateTemplate_FullMethodName      = "/assetsbalancer.v1.PortfolioTemplates/UpdateTemplate"
+	PortfolioTemplates_DeleteTemplate_FullMethodName      = "/assetsbalancer.v1.PortfolioTemplates/DeleteTemplate"
+	PortfolioTemplates_InstantiateTemplate_FullMethodName = "/assetsbalancer.v1.PortfolioTemplates/InstantiateTemplate"
+)
+
+// PortfolioTemplatesClient is the client API for PortfolioTemplates service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PortfolioTemplatesClient interface {
+	GetTemplates(ctx context.Context, in *GetTemplatesRequest, opts ...grpc.CallOption) (*GetTemplatesResponse, error)
+	GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*PortfolioTemplate, error)
+	CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*PortfolioTemplate, error)
+	UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*PortfolioTemplate, error)
+	DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	InstantiateTemplate(ctx context.Context, in *InstantiateTemplateRequest, opts ...grpc.CallOption) (*AssetsGroup, error)
+}
+
+type portfolioTemplatesClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPortfolioTemplatesClient(cc grpc.ClientConnInterface) PortfolioTemplatesClient {
+	return &portfolioTemplatesClient{cc}
+}
+
+func (c *portfolioTemplatesClient) GetTemplates(ctx context.Context, in *GetTemplatesRequest, opts ...grpc.CallOption) (*GetTemplatesResponse, error) {
+	out := new(GetTemplatesResponse)
+	err := c.cc.Invoke(ctx, PortfolioTemplates_GetTemplates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioTemplatesClient) GetTemplate(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*PortfolioTemplate, error) {
+	out := new(PortfolioTemplate)
+	err := c.cc.Invoke(ctx, PortfolioTemplates_GetTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioTemplatesClient) CreateTemplate(ctx context.Context, in *CreateTemplateRequest, opts ...grpc.CallOption) (*PortfolioTemplate, error) {
+	out := new(PortfolioTemplate)
+	err := c.cc.Invoke(ctx, PortfolioTemplates_CreateTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioTemplatesClient) UpdateTemplate(ctx context.Context, in *UpdateTemplateRequest, opts ...grpc.CallOption) (*PortfolioTemplate, error) {
+	out := new(PortfolioTemplate)
+	err := c.cc.Invoke(ctx, PortfolioTemplates_UpdateTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioTemplatesClient) DeleteTemplate(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, PortfolioTemplates_DeleteTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *portfolioTemplatesClient) InstantiateTemplate(ctx context.Context, in *InstantiateTemplateRequest, opts ...grpc.CallOption) (*AssetsGroup, error) {
+	out := new(AssetsGroup)
+	err := c.cc.Invoke(ctx, PortfolioTemplates_InstantiateTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PortfolioTemplatesServer is the server API for PortfolioTemplates service.
+// All implementations must embed UnimplementedPortfolioTemplatesServer
+// for forward compatibility
+type PortfolioTemplatesServer interface {
+	GetTemplates(context.Context, *GetTemplatesRequest) (*GetTemplatesResponse, error)
+	GetTemplate(context.Context, *GetTemplateRequest) (*PortfolioTemplate, error)
+	CreateTemplate(context.Context, *CreateTemplateRequest) (*PortfolioTemplate, error)
+	UpdateTemplate(context.Context, *UpdateTemplateRequest) (*PortfolioTemplate, error)
+	DeleteTemplate(context.Context, *DeleteTemplateRequest) (*emptypb.Empty, error)
+	InstantiateTemplate(context.Context, *InstantiateTemplateRequest) (*AssetsGroup, error)
+	mustEmbedUnimplementedPortfolioTemplatesServer()
+}
+
+// UnimplementedPortfolioTemplatesServer must be embedded to have forward compatible implementations.
+type UnimplementedPortfolioTemplatesServer struct {
+}
+
+func (UnimplementedPortfolioTemplatesServer) GetTemplates(context.Context, *GetTemplatesRequest) (*GetTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplates not implemented")
+}
+func (UnimplementedPortfolioTemplatesServer) GetTemplate(context.Context, *GetTemplateRequest) (*PortfolioTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTemplate not implemented")
+}
+func (UnimplementedPortfolioTemplatesServer) CreateTemplate(context.Context, *CreateTemplateRequest) (*PortfolioTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTemplate not implemented")
+}
+func (UnimplementedPortfolioTemplatesServer) UpdateTemplate(context.Context, *UpdateTemplateRequest) (*PortfolioTemplate, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTemplate not implemented")
+}
+func (UnimplementedPortfolioTemplatesServer) DeleteTemplate(context.Context, *DeleteTemplateRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTemplate not implemented")
+}
+func (UnimplementedPortfolioTemplatesServer) InstantiateTemplate(context.Context, *InstantiateTemplateRequest) (*AssetsGroup, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InstantiateTemplate not implemented")
+}
+func (UnimplementedPortfolioTemplatesServer) mustEmbedUnimplementedPortfolioTemplatesServer() {}
+
+// UnsafePortfolioTemplatesServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PortfolioTemplatesServer will
+// result in compilation errors.
+type UnsafePortfolioTemplatesServer interface {
+	mustEmbedUnimplementedPortfolioTemplatesServer()
+}
+
+func RegisterPortfolioTemplatesServer(s grpc.ServiceRegistrar, srv PortfolioTemplatesServer) {
+	s.RegisterService(&PortfolioTemplates_ServiceDesc, srv)
+}
+
+func _PortfolioTemplates_GetTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioTemplatesServer).GetTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioTemplates_GetTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioTemplatesServer).GetTemplates(ctx, req.(*GetTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioTemplates_GetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioTemplatesServer).GetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioTemplates_GetTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioTemplatesServer).GetTemplate(ctx, req.(*GetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioTemplates_CreateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioTemplatesServer).CreateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioTemplates_CreateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioTemplatesServer).CreateTemplate(ctx, req.(*CreateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioTemplates_UpdateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioTemplatesServer).UpdateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioTemplates_UpdateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioTemplatesServer).UpdateTemplate(ctx, req.(*UpdateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioTemplates_DeleteTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioTemplatesServer).DeleteTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioTemplates_DeleteTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioTemplatesServer).DeleteTemplate(ctx, req.(*DeleteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PortfolioTemplates_InstantiateTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InstantiateTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PortfolioTemplatesServer).InstantiateTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PortfolioTemplates_InstantiateTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PortfolioTemplatesServer).InstantiateTemplate(ctx, req.(*InstantiateTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PortfolioTemplates_ServiceDesc is the grpc.ServiceDesc for PortfolioTemplates service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PortfolioTemplates_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "assetsbalancer.v1.PortfolioTemplates",
+	HandlerType: (*PortfolioTemplatesServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetTemplates",
+			Handler:    _PortfolioTemplates_GetTemplates_Handler,
+		},
+		{
+			MethodName: "GetTemplate",
+			Handler:    _PortfolioTemplates_GetTemplate_Handler,
+		},
+		{
+			MethodName: "CreateTemplate",
+			Handler:    _PortfolioTemplates_CreateTemplate_Handler,
+		},
+		{
+			MethodName: "UpdateTemplate",
+			Handler:    _PortfolioTemplates_UpdateTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteTemplate",
+			Handler:    _PortfolioTemplates_DeleteTemplate_Handler,
+		},
+		{
+			MethodName: "InstantiateTemplate",
+			Handler:    _PortfolioTemplates_InstantiateTemplate_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "assets_balancer.proto",
+}
@@ -0,0 +1,190 @@
+package adapters
+
+import (
+	"context"
+
+	"github.com/romaopatrick/assets-balancer/internal/adapters/pb"
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+type (
+	// PortfolioTemplateGrpcServer serves the PortfolioTemplateUseCase over
+	// gRPC, like AssetsBalancerGrpcServer does for the groups.
+	PortfolioTemplateGrpcServer struct {
+		pb.UnimplementedPortfolioTemplatesServer
+		useCase ports.PortfolioTemplateUseCase
+	}
+)
+
+func NewPortfolioTemplateGrpcServer(uc ports.PortfolioTemplateUseCase) *PortfolioTemplateGrpcServer {
+	return &PortfolioTemplateGrpcServer{
+		useCase: uc,
+	}
+}
+
+func (s *PortfolioTemplateGrpcServer) GetTemplates(
+	ctx context.Context, req *pb.GetTemplatesRequest) (*pb.GetTemplatesResponse, error) {
+	res := &pb.GetTemplatesResponse{}
+	for _, t := range s.useCase.GetTemplates(ctx) {
+		res.Templates = append(res.Templates, toPbTemplate(t))
+	}
+
+	return res, nil
+}
+
+func (s *PortfolioTemplateGrpcServer) GetTemplate(
+	ctx context.Context, req *pb.GetTemplateRequest) (*pb.PortfolioTemplate, error) {
+	id, err := parseGrpcId("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	template := s.useCase.GetTemplate(ctx, &boundaries.GetPortfolioTemplateInput{
+		Id: id,
+	})
+	if template == nil {
+		return nil, status.Error(codes.NotFound, domain.TEMPLATE_NOT_FOUND)
+	}
+
+	return toPbTemplate(template), nil
+}
+
+func (s *PortfolioTemplateGrpcServer) CreateTemplate(
+	ctx context.Context, req *pb.CreateTemplateRequest) (*pb.PortfolioTemplate, error) {
+	assets, err := fromPbTemplateAssets(req.Assets)
+	if err != nil {
+		return nil, err
+	}
+	input := &boundaries.CreatePortfolioTemplateInput{
+		Label:       req.Label,
+		Description: req.Description,
+		Tags:        req.Tags,
+		Assets:      assets,
+	}
+	if err := validateGrpcInput(input); err != nil {
+		return nil, err
+	}
+
+	return toPbTemplateResult(s.useCase.CreateTemplate(ctx, input))
+}
+
+func (s *PortfolioTemplateGrpcServer) UpdateTemplate(
+	ctx context.Context, req *pb.UpdateTemplateRequest) (*pb.PortfolioTemplate, error) {
+	id, err := parseGrpcId("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+	assets, err := fromPbTemplateAssets(req.Assets)
+	if err != nil {
+		return nil, err
+	}
+	input := &boundaries.UpdatePortfolioTemplateInput{
+		Id:          id,
+		Label:       req.Label,
+		Description: req.Description,
+		Tags:        req.Tags,
+		Assets:      assets,
+	}
+	if err := validateGrpcInput(input); err != nil {
+		return nil, err
+	}
+
+	return toPbTemplateResult(s.useCase.UpdateTemplate(ctx, input))
+}
+
+func (s *PortfolioTemplateGrpcServer) DeleteTemplate(
+	ctx context.Context, req *pb.DeleteTemplateRequest) (*emptypb.Empty, error) {
+	id, err := parseGrpcId("id", req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.useCase.DeleteTemplate(ctx, &boundaries.DeletePortfolioTemplateInput{
+		Id: id,
+	}); err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (s *PortfolioTemplateGrpcServer) InstantiateTemplate(
+	ctx context.Context, req *pb.InstantiateTemplateRequest) (*pb.AssetsGroup, error) {
+	templateId, err := parseGrpcId("template_id", req.TemplateId)
+	if err != nil {
+		return nil, err
+	}
+	input := &boundaries.InstantiatePortfolioTemplateInput{
+		TemplateId:        templateId,
+		Label:             req.Label,
+		ContributionTotal: req.ContributionTotal,
+	}
+	if err := validateGrpcInput(input); err != nil {
+		return nil, err
+	}
+
+	return toPbAssetsGroupResult(s.useCase.InstantiateTemplate(ctx, input))
+}
+
+// fromPbTemplateAssets keeps the ids left unset as uuid.Nil, the assets
+// new to the template.
+func fromPbTemplateAssets(assets []*pb.TemplateAssetInput) ([]boundaries.TemplateAssetInput, error) {
+	res := []boundaries.TemplateAssetInput{}
+	for _, a := range assets {
+		var id uuid.UUID
+		if a.Id != "" {
+			var err error
+			if id, err = parseGrpcId("id", a.Id); err != nil {
+				return nil, err
+			}
+		}
+		res = append(res, boundaries.TemplateAssetInput{
+			Id:      id,
+			Label:   a.Label,
+			Ticker:  a.Ticker,
+			Score:   a.Score,
+			Include: a.Include,
+		})
+	}
+
+	return res, nil
+}
+
+func toPbTemplateResult(template *domain.PortfolioTemplate, err error) (*pb.PortfolioTemplate, error) {
+	if err != nil {
+		return nil, toGrpcError(err)
+	}
+
+	return toPbTemplate(template), nil
+}
+
+func toPbTemplate(template *domain.PortfolioTemplate) *pb.PortfolioTemplate {
+	res := &pb.PortfolioTemplate{
+		Id:          template.Id.String(),
+		Label:       template.Label,
+		Description: template.Description,
+		Tags:        template.Tags,
+		Owner:       template.Owner,
+		CreatedAt:   timestamppb.New(template.CreatedAt),
+		UpdatedAt:   timestamppb.New(template.UpdatedAt),
+	}
+	for _, a := range template.Assets {
+		res.Assets = append(res.Assets, &pb.TemplateAsset{
+			Id:      a.Id.String(),
+			Label:   a.Label,
+			Ticker:  a.Ticker,
+			Score:   a.Score,
+			Include: a.Include,
+		})
+	}
+
+	return res
+}
@@ -0,0 +1,137 @@
+package adapters
+
+import (
+	"net/http"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+)
+
+type (
+	// PortfolioTemplateHandler serves the model portfolios as REST
+	// resources, like the v2 groups.
+	PortfolioTemplateHandler struct {
+		useCase ports.PortfolioTemplateUseCase
+	}
+)
+
+func (h *PortfolioTemplateHandler) HandleGetTemplates(c *gin.Context) {
+	res := h.useCase.GetTemplates(c)
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *PortfolioTemplateHandler) HandleGetTemplate(c *gin.Context) {
+	templateId, ok := pathId(c, "templateId")
+	if !ok {
+		return
+	}
+
+	res := h.useCase.GetTemplate(c, &boundaries.GetPortfolioTemplateInput{
+		Id: templateId,
+	})
+	if res == nil {
+		c.AbortWithStatusJSON(http.StatusNotFound, newErrorResult(domain.TEMPLATE_NOT_FOUND))
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *PortfolioTemplateHandler) HandleCreateTemplate(c *gin.Context) {
+	input := &boundaries.CreatePortfolioTemplateInput{}
+
+	if err := c.ShouldBindJSON(&input); err != nil {
+		c.AbortWithStatusJSON(http.StatusUnprocessableEntity, newBindingErrorResult(err))
+		return
+	}
+
+	res, err := h.useCase.CreateTemplate(c, input)
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.Header("Location", templateLocation(res.Id))
+	c.JSON(http.StatusCreated, res)
+}
+
+func (h *PortfolioTemplateHandler) HandleUpdateTemplate(c *gin.Context) {
+	templateId, ok := pathId(c, "templateId")
+	if !ok {
+		return
+	}
+	input := &boundaries.UpdatePortfolioTemplateInput{}
+
+	if !bindJSONWithPath(c, input, func() {
+		input.Id = templateId
+	}) {
+		return
+	}
+
+	res, err := h.useCase.UpdateTemplate(c, input)
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+func (h *PortfolioTemplateHandler) HandleDeleteTemplate(c *gin.Context) {
+	templateId, ok := pathId(c, "templateId")
+	if !ok {
+		return
+	}
+
+	err := h.useCase.DeleteTemplate(c, &boundaries.DeletePortfolioTemplateInput{
+		Id: templateId,
+	})
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.Status(http.StatusNoContent)
+}
+
+func (h *PortfolioTemplateHandler) HandleInstantiateTemplate(c *gin.Context) {
+	templateId, ok := pathId(c, "templateId")
+	if !ok {
+		return
+	}
+	input := &boundaries.InstantiatePortfolioTemplateInput{}
+
+	if !bindJSONWithPath(c, input, func() {
+		input.TemplateId = templateId
+	}) {
+		return
+	}
+
+	res, err := h.useCase.InstantiateTemplate(c, input)
+
+	if err != nil {
+		abortWithV2Error(c, err)
+		return
+	}
+
+	c.Header("Location", groupLocation(res.Id))
+	c.JSON(http.StatusCreated, res)
+}
+
+func templateLocation(templateId uuid.UUID) string {
+	return "/v2/templates/" + templateId.String()
+}
+
+func NewPortfolioTemplateHandler(uc ports.PortfolioTemplateUseCase) *PortfolioTemplateHandler {
+	return &PortfolioTemplateHandler{
+		useCase: uc,
+	}
+}
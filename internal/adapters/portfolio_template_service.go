@@ -0,0 +1,216 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/google/uuid"
+	"github.com/spf13/viper"
+)
+
+type (
+	PortfolioTemplateService struct {
+		repository      ports.Repository[*domain.PortfolioTemplate]
+		groupRepository ports.Repository[*domain.AssetsGroup]
+		publisher       ports.NotificationPublisher
+		journal         *ChangeJournal
+		limits          *groupLimits
+		now             func() time.Time
+	}
+)
+
+func NewPortfolioTemplateUseCase(
+	cfg *viper.Viper,
+	repository ports.Repository[*domain.PortfolioTemplate],
+	groupRepository ports.Repository[*domain.AssetsGroup],
+	publisher ports.NotificationPublisher,
+	journal *ChangeJournal) ports.PortfolioTemplateUseCase {
+	return &PortfolioTemplateService{
+		repository:      repository,
+		groupRepository: groupRepository,
+		publisher:       publisher,
+		journal:         journal,
+		limits:          newGroupLimits(cfg),
+		now:             time.Now,
+	}
+}
+
+func (pts *PortfolioTemplateService) GetTemplates(ctx context.Context) []*domain.PortfolioTemplate {
+	return pts.repository.GetAll(ctx, map[string]interface{}{})
+}
+
+func (pts *PortfolioTemplateService) GetTemplate(
+	ctx context.Context, input *boundaries.GetPortfolioTemplateInput) *domain.PortfolioTemplate {
+	return pts.repository.GetFirst(ctx, map[string]interface{}{
+		"id": input.Id,
+	})
+}
+
+func (pts *PortfolioTemplateService) CreateTemplate(
+	ctx context.Context, input *boundaries.CreatePortfolioTemplateInput) (_ *domain.PortfolioTemplate, err error) {
+	defer func() { logMutation(ctx, "CreateTemplate", err, "label", input.Label) }()
+	if err := pts.limits.checkAssets(len(input.Assets)); err != nil {
+		return nil, err
+	}
+	assets := []*domain.TemplateAsset{}
+	for _, a := range input.Assets {
+		assets = append(assets, domain.NewTemplateAsset(a.Label, a.Ticker, a.Score, a.Include))
+	}
+
+	template, err := domain.NewPortfolioTemplate(input.Label, input.Description, input.Tags,
+		assets, domain.PrincipalFromContext(ctx), pts.now())
+	if err != nil {
+		return nil, err
+	}
+	if err := pts.journal.SaveTemplate(ctx, template, func(ctx context.Context) error {
+		pts.repository.Insert(ctx, template)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return template, nil
+}
+
+// UpdateTemplate replaces the template and brings every group made from
+// it in line with its new assets, in one transaction: when a group fails
+// to sync, the template isn't updated either. Only the owner of the
+// template may update it.
+func (pts *PortfolioTemplateService) UpdateTemplate(
+	ctx context.Context, input *boundaries.UpdatePortfolioTemplateInput) (_ *domain.PortfolioTemplate, err error) {
+	defer func() { logMutation(ctx, "UpdateTemplate", err, "template_id", input.Id) }()
+	template := pts.GetTemplate(ctx, &boundaries.GetPortfolioTemplateInput{
+		Id: input.Id,
+	})
+
+	if template == nil {
+		return nil, domain.ErrTemplateNotFound
+	}
+	if !template.IsOwnedBy(domain.PrincipalFromContext(ctx)) {
+		return nil, domain.ErrTemplateNotOwned
+	}
+	if err := pts.limits.checkAssets(len(input.Assets)); err != nil {
+		return nil, err
+	}
+
+	assets := []*domain.TemplateAsset{}
+	for _, a := range input.Assets {
+		ta := domain.NewTemplateAsset(a.Label, a.Ticker, a.Score, a.Include)
+		if a.Id != uuid.Nil {
+			if template.FindAsset(a.Id) == nil {
+				return nil, domain.ErrAssetNotFound
+			}
+			ta.Id = a.Id
+		}
+		assets = append(assets, ta)
+	}
+	if err := template.Update(input.Label, input.Description, input.Tags, assets, pts.now()); err != nil {
+		return nil, err
+	}
+
+	var synced []*domain.AssetsGroup
+	if err := pts.journal.SaveTemplate(ctx, template, func(ctx context.Context) error {
+		pts.repository.Replace(ctx, map[string]interface{}{
+			"id": template.Id,
+		}, template)
+		groups, err := pts.syncGroups(ctx, template)
+		synced = groups
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	for _, g := range synced {
+		publishGroupModified(ctx, pts.publisher, g)
+	}
+
+	return template, nil
+}
+
+// DeleteTemplate deletes the template. The groups made from it keep
+// their assets and aren't synced anymore. Only the owner of the template
+// may delete it.
+func (pts *PortfolioTemplateService) DeleteTemplate(
+	ctx context.Context, input *boundaries.DeletePortfolioTemplateInput) (err error) {
+	defer func() { logMutation(ctx, "DeleteTemplate", err, "template_id", input.Id) }()
+	template := pts.GetTemplate(ctx, &boundaries.GetPortfolioTemplateInput{
+		Id: input.Id,
+	})
+
+	if template == nil {
+		return domain.ErrTemplateNotFound
+	}
+	if !template.IsOwnedBy(domain.PrincipalFromContext(ctx)) {
+		return domain.ErrTemplateNotOwned
+	}
+	template.Delete(pts.now())
+
+	return pts.journal.SaveTemplate(ctx, template, func(ctx context.Context) error {
+		pts.repository.DeleteAll(ctx, map[string]interface{}{
+			"id": template.Id,
+		})
+		return nil
+	})
+}
+
+// InstantiateTemplate makes a group from the template for the principal of
+// ctx, with its assets and no values yet.
+func (pts *PortfolioTemplateService) InstantiateTemplate(
+	ctx context.Context, input *boundaries.InstantiatePortfolioTemplateInput) (_ *domain.AssetsGroup, err error) {
+	defer func() { logMutation(ctx, "InstantiateTemplate", err, "template_id", input.TemplateId) }()
+	template := pts.GetTemplate(ctx, &boundaries.GetPortfolioTemplateInput{
+		Id: input.TemplateId,
+	})
+
+	if template == nil {
+		return nil, domain.ErrTemplateNotFound
+	}
+	owner := domain.PrincipalFromContext(ctx)
+	assetsGroup := template.NewAssetsGroup(input.Label, input.ContributionTotal)
+	assetsGroup.Owner = owner
+	recordGroupCreated(assetsGroup)
+
+	if err := pts.journal.Save(ctx, assetsGroup, func(ctx context.Context) error {
+		if err := pts.limits.checkGroups(ctx, pts.groupRepository, owner); err != nil {
+			return err
+		}
+		pts.groupRepository.Insert(ctx, assetsGroup)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	publishGroupModified(ctx, pts.publisher, assetsGroup)
+
+	return assetsGroup, nil
+}
+
+// syncGroups saves each group linked to the template that its changes
+// affect, within the transaction of ctx, and returns them. It stops at
+// the first group that fails to save.
+func (pts *PortfolioTemplateService) syncGroups(
+	ctx context.Context, template *domain.PortfolioTemplate) ([]*domain.AssetsGroup, error) {
+	groups := pts.groupRepository.GetAll(ctx, notDeleted(map[string]interface{}{
+		"templateid": template.Id,
+	}))
+	synced := []*domain.AssetsGroup{}
+	for _, g := range groups {
+		if !g.SyncWithTemplate(template) {
+			continue
+		}
+		balance(ctx, g)
+
+		err := pts.journal.Save(ctx, g, func(ctx context.Context) error {
+			return replaceGroup(ctx, pts.groupRepository, g)
+		})
+		logMutation(ctx, "SyncWithTemplate", err, "group_id", g.Id, "template_id", template.Id)
+		if err != nil {
+			return nil, err
+		}
+		synced = append(synced, g)
+	}
+
+	return synced, nil
+}
@@ -0,0 +1,190 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Should_InstantiateTemplatesAndSyncTheirGroups(t *testing.T) {
+	assert := assert.New(t)
+	templates := newMockedInsertingRepository[*domain.PortfolioTemplate]()
+	templates.mockGetFirst = func(filter map[string]interface{}) *domain.PortfolioTemplate {
+		return templates.mockedDatabase[0]
+	}
+	templates.mockReplace = func(filter map[string]interface{}, entity *domain.PortfolioTemplate) {}
+	groups := newMockedInsertingRepository[*domain.AssetsGroup]()
+	groups.mockGetAll = func(filter map[string]interface{}) []*domain.AssetsGroup {
+		return groups.mockedDatabase
+	}
+	replaced := 0
+	groups.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {
+		replaced++
+	}
+	publisher := &mockedNotificationPublisher{}
+	s := NewPortfolioTemplateUseCase(viper.New(), templates, groups, publisher, newMockedChangeJournal())
+	ctx := domain.WithPrincipal(context.Background(), "alice")
+
+	template, err := s.CreateTemplate(ctx, &boundaries.CreatePortfolioTemplateInput{
+		Label: "60/40",
+		Assets: []boundaries.TemplateAssetInput{
+			{Label: "Stocks", Score: 60, Include: true},
+			{Label: "Bonds", Score: 40, Include: true},
+		},
+	})
+	if !assert.Nil(err) {
+		t.FailNow()
+	}
+	group, err := s.InstantiateTemplate(ctx, &boundaries.InstantiatePortfolioTemplateInput{
+		TemplateId:        template.Id,
+		Label:             "mine",
+		ContributionTotal: 1000,
+	})
+	if !assert.Nil(err) {
+		t.FailNow()
+	}
+	assert.Equal("alice", group.Owner)
+	assert.Equal([]*domain.AssetsGroup{group}, groups.mockedDatabase)
+
+	_, err = s.UpdateTemplate(ctx, &boundaries.UpdatePortfolioTemplateInput{
+		Id:    template.Id,
+		Label: "70/30",
+		Assets: []boundaries.TemplateAssetInput{
+			{Id: template.Assets[0].Id, Label: "Stocks", Score: 70, Include: true},
+			{Id: template.Assets[1].Id, Label: "Bonds", Score: 30, Include: true},
+		},
+	})
+	if !assert.Nil(err) {
+		t.FailNow()
+	}
+	assert.Equal(1, replaced)
+	assert.EqualValues(70, group.Assets[0].Score)
+	assert.EqualValues(700, group.Assets[0].FinalContribution)
+	assert.Len(publisher.published, 2)
+
+	_, err = s.UpdateTemplate(ctx, &boundaries.UpdatePortfolioTemplateInput{
+		Id:    template.Id,
+		Label: "uneven",
+		Assets: []boundaries.TemplateAssetInput{
+			{Id: template.Assets[0].Id, Label: "Stocks", Score: 70, Include: true},
+		},
+	})
+	assert.EqualError(err, domain.INVALID_SCORE_SUM)
+	assert.Equal(1, replaced)
+}
+
+func Test_Should_OnlyLetTheOwnerChangeATemplateAndAuditTheChanges(t *testing.T) {
+	assert := assert.New(t)
+	templates := newMockedInsertingRepository[*domain.PortfolioTemplate]()
+	templates.mockGetFirst = func(filter map[string]interface{}) *domain.PortfolioTemplate {
+		return templates.mockedDatabase[0]
+	}
+	templates.mockReplace = func(filter map[string]interface{}, entity *domain.PortfolioTemplate) {}
+	templates.mockDeleteAll = func(filter map[string]interface{}) int64 {
+		templates.mockedDatabase = nil
+		return 1
+	}
+	groups := newMockedRepository[*domain.AssetsGroup]()
+	groups.mockGetAll = func(filter map[string]interface{}) []*domain.AssetsGroup {
+		return nil
+	}
+	audit := newMockedInsertingRepository[*domain.AuditEntry]()
+	journal := NewChangeJournal(&mockedTransactor{}, newMockedInsertingRepository[*domain.DomainEvent](), audit,
+		NewInProcessGroupUpdateBroker())
+	s := NewPortfolioTemplateUseCase(viper.New(), templates, groups, &mockedNotificationPublisher{}, journal)
+	alice := domain.WithActor(domain.WithPrincipal(context.Background(), "alice"), "alice")
+	bob := domain.WithPrincipal(context.Background(), "bob")
+
+	template, err := s.CreateTemplate(alice, &boundaries.CreatePortfolioTemplateInput{
+		Label:  "all in",
+		Assets: []boundaries.TemplateAssetInput{{Label: "Stocks", Score: 100, Include: true}},
+	})
+	if !assert.Nil(err) {
+		t.FailNow()
+	}
+	update := &boundaries.UpdatePortfolioTemplateInput{
+		Id:     template.Id,
+		Label:  "renamed",
+		Assets: []boundaries.TemplateAssetInput{{Id: template.Assets[0].Id, Label: "Stocks", Score: 100, Include: true}},
+	}
+
+	_, err = s.UpdateTemplate(bob, update)
+	assert.ErrorIs(err, domain.ErrTemplateNotOwned)
+	assert.ErrorIs(s.DeleteTemplate(bob, &boundaries.DeletePortfolioTemplateInput{Id: template.Id}),
+		domain.ErrTemplateNotOwned)
+	assert.Equal("all in", template.Label)
+
+	_, err = s.UpdateTemplate(alice, update)
+	assert.Nil(err)
+	assert.Nil(s.DeleteTemplate(alice, &boundaries.DeletePortfolioTemplateInput{Id: template.Id}))
+	assert.Empty(templates.mockedDatabase)
+	if assert.Len(audit.mockedDatabase, 3) {
+		assert.Equal(domain.AUDIT_CREATE_TEMPLATE, audit.mockedDatabase[0].Operation)
+		assert.Equal(domain.AUDIT_UPDATE_TEMPLATE, audit.mockedDatabase[1].Operation)
+		assert.Equal(domain.AUDIT_DELETE_TEMPLATE, audit.mockedDatabase[2].Operation)
+		assert.Equal("alice", audit.mockedDatabase[1].Actor)
+	}
+}
+
+func Test_Should_Not_UpdateATemplateWhenAGroupFailsToSync(t *testing.T) {
+	assert := assert.New(t)
+	template, _ := domain.NewPortfolioTemplate("all in", "", nil, []*domain.TemplateAsset{
+		domain.NewTemplateAsset("Stocks", "", 100, true),
+	}, domain.ANONYMOUS_PRINCIPAL, time.Now())
+	templates := newMockedRepository[*domain.PortfolioTemplate]()
+	templates.mockGetFirst = func(filter map[string]interface{}) *domain.PortfolioTemplate {
+		return template
+	}
+	templates.mockReplace = func(filter map[string]interface{}, entity *domain.PortfolioTemplate) {}
+	group := template.NewAssetsGroup("mine", 100)
+	groups := newMockedRepository[*domain.AssetsGroup]()
+	groups.mockGetAll = func(filter map[string]interface{}) []*domain.AssetsGroup {
+		return []*domain.AssetsGroup{group}
+	}
+	groups.mockMatches = func(filter map[string]interface{}) bool { return false }
+	groups.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {}
+	publisher := &mockedNotificationPublisher{}
+	s := NewPortfolioTemplateUseCase(viper.New(), templates, groups, publisher, newMockedChangeJournal())
+
+	_, err := s.UpdateTemplate(context.Background(), &boundaries.UpdatePortfolioTemplateInput{
+		Id:    template.Id,
+		Label: "half",
+		Assets: []boundaries.TemplateAssetInput{
+			{Id: template.Assets[0].Id, Label: "Stocks", Score: 50, Include: true},
+			{Label: "Bonds", Score: 50, Include: true},
+		},
+	})
+
+	assert.ErrorIs(err, domain.ErrAssetsGroupConflict)
+	assert.Empty(publisher.published)
+}
+
+func Test_Should_CloneAssetsGroupForThePrincipal(t *testing.T) {
+	assert := assert.New(t)
+	source := domain.NewAssetGroup("source", []*domain.Asset{
+		domain.NewAsset("RF", 100, 0, 100, 100, 0, true),
+	}, 100)
+	source.Owner = "alice"
+	r := newMockedInsertingRepository[*domain.AssetsGroup]()
+	r.mockGetFirst = func(filter map[string]interface{}) *domain.AssetsGroup {
+		return source
+	}
+	s := NewAssetsBalancerUseCase(viper.New(), r, &mockedNotificationPublisher{}, newMockedChangeJournal())
+
+	clone, err := s.CloneAssetsGroup(domain.WithPrincipal(context.Background(), "bob"),
+		&boundaries.CloneAssetsGroupInput{Id: source.Id, Label: "copy"})
+
+	if !assert.Nil(err) {
+		t.FailNow()
+	}
+	assert.Equal([]*domain.AssetsGroup{clone}, r.mockedDatabase)
+	assert.Equal("bob", clone.Owner)
+	assert.NotEqual(source.Id, clone.Id)
+	assert.EqualValues(100, clone.Assets[0].CurrentValue)
+}
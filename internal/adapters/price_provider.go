@@ -0,0 +1,128 @@
+package adapters
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/spf13/viper"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type (
+	FilePriceProvider struct {
+		path string
+	}
+	RepositoryPriceProvider struct {
+		repository ports.Repository[*domain.AssetPrice]
+	}
+	HttpPriceProvider struct {
+		client  *http.Client
+		baseUrl string
+	}
+	httpPriceResult struct {
+		Ticker string
+		Price  float64
+	}
+)
+
+// NewPriceProvider picks the price provider configured under
+// "prices.provider": "file", "mongodb" or "http".
+func NewPriceProvider(cfg *viper.Viper, db *mongo.Database) ports.PriceProvider {
+	switch cfg.GetString("prices.provider") {
+	case "http":
+		return NewHttpPriceProvider(
+			cfg.GetString("prices.http.baseUrl"),
+			cfg.GetDuration("prices.http.timeout"))
+	case "mongodb":
+		return NewRepositoryPriceProvider(NewMongoDbRepository[*domain.AssetPrice](db))
+	default:
+		return NewFilePriceProvider(cfg.GetString("prices.file"))
+	}
+}
+
+func NewFilePriceProvider(path string) *FilePriceProvider {
+	return &FilePriceProvider{
+		path: path,
+	}
+}
+
+// GetPrice reads the file on every call so prices can be edited
+// without restarting the service. The file maps tickers to prices.
+func (p *FilePriceProvider) GetPrice(ctx context.Context, ticker string) (float64, error) {
+	content, err := os.ReadFile(p.path)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", domain.PRICE_PROVIDER_FAILED, err)
+	}
+	prices := map[string]float64{}
+	if err := json.Unmarshal(content, &prices); err != nil {
+		return 0, fmt.Errorf("%s: %w", domain.PRICE_PROVIDER_FAILED, err)
+	}
+	price, ok := prices[strings.ToUpper(ticker)]
+	if !ok {
+		return 0, domain.ErrPriceNotFound
+	}
+
+	return price, nil
+}
+
+func NewRepositoryPriceProvider(repository ports.Repository[*domain.AssetPrice]) *RepositoryPriceProvider {
+	return &RepositoryPriceProvider{
+		repository: repository,
+	}
+}
+
+func (p *RepositoryPriceProvider) GetPrice(ctx context.Context, ticker string) (float64, error) {
+	price := p.repository.GetFirst(ctx, map[string]interface{}{
+		"ticker": strings.ToUpper(ticker),
+	})
+	if price == nil {
+		return 0, domain.ErrPriceNotFound
+	}
+
+	return price.Price, nil
+}
+
+func NewHttpPriceProvider(baseUrl string, timeout time.Duration) *HttpPriceProvider {
+	return &HttpPriceProvider{
+		client:  &http.Client{Timeout: timeout},
+		baseUrl: strings.TrimSuffix(baseUrl, "/"),
+	}
+}
+
+// GetPrice requests GET {baseUrl}/{ticker} and expects a body like
+// {"ticker": "IVVB11", "price": 250.3}.
+func (p *HttpPriceProvider) GetPrice(ctx context.Context, ticker string) (float64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet,
+		p.baseUrl+"/"+url.PathEscape(strings.ToUpper(ticker)), nil)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", domain.PRICE_PROVIDER_FAILED, err)
+	}
+	res, err := p.client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", domain.PRICE_PROVIDER_FAILED, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusNotFound {
+		return 0, domain.ErrPriceNotFound
+	}
+	if res.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("%s: unexpected status %d", domain.PRICE_PROVIDER_FAILED, res.StatusCode)
+	}
+
+	result := &httpPriceResult{}
+	if err := json.NewDecoder(res.Body).Decode(result); err != nil {
+		return 0, fmt.Errorf("%s: %w", domain.PRICE_PROVIDER_FAILED, err)
+	}
+
+	return result.Price, nil
+}
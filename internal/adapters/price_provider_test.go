@@ -0,0 +1,55 @@
+package adapters
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Should_GetPriceFromFile(t *testing.T) {
+	assert := assert.New(t)
+	path := filepath.Join(t.TempDir(), "prices.json")
+	if err := os.WriteFile(path, []byte(`{"IVVB11": 250.5}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	p := NewFilePriceProvider(path)
+
+	price, err := p.GetPrice(context.Background(), "ivvb11")
+	if !assert.Nil(err) ||
+		!assert.EqualValues(250.5, price) {
+		t.FailNow()
+	}
+
+	_, err = p.GetPrice(context.Background(), "PETR4")
+	assert.EqualError(err, domain.PRICE_NOT_FOUND)
+}
+
+func Test_Should_GetPriceFromHttp(t *testing.T) {
+	assert := assert.New(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/prices/IVVB11" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"ticker": "IVVB11", "price": 250.5}`))
+	}))
+	defer srv.Close()
+	p := NewHttpPriceProvider(srv.URL+"/prices/", time.Second)
+
+	price, err := p.GetPrice(context.Background(), "IVVB11")
+	if !assert.Nil(err) ||
+		!assert.EqualValues(250.5, price) {
+		t.FailNow()
+	}
+
+	_, err = p.GetPrice(context.Background(), "PETR4")
+	assert.EqualError(err, domain.PRICE_NOT_FOUND)
+}
@@ -1,32 +1,90 @@
 package adapters
 
 import (
-	"time"
-
-	"github.com/gin-contrib/cors"
 	"github.com/gin-gonic/gin"
 )
 
 func ConfigureRouter(eng *gin.Engine,
-	ph *AssetsBalancerHandler) {
-	eng.Use(cors.New(cors.Config{
-		AllowOrigins:     []string{"*"},
-		AllowMethods:     []string{"*"},
-		AllowHeaders:     []string{"*"},
-		ExposeHeaders:    []string{"Content-Length"},
-		AllowCredentials: true,
-		AllowOriginFunc: func(origin string) bool {
-			return true
-		},
-		MaxAge: 12 * time.Hour,
-	}))
+	ph *AssetsBalancerHandler,
+	sh *ContributionScheduleHandler,
+	nh *NotificationHandler,
+	ah *AuditHandler,
+	v2h *AssetsBalancerV2Handler,
+	gh *GraphQlHandler,
+	gsh *GroupStreamHandler,
+	hh *HealthHandler,
+	mh *MetricsHandler,
+	ih *IdempotencyHandler,
+	th *PortfolioTemplateHandler) {
+	eng.Use(TracingMiddleware())
+	eng.Use(MetricsMiddleware())
+	eng.GET("metrics", mh.HandleMetrics)
+	eng.GET("healthz", hh.HandleLiveness)
+	eng.GET("readyz", hh.HandleReadiness)
+	eng.Use(ActorMiddleware())
 	v1 := eng.Group("v1")
-	v1.POST("assetsGroup", ph.HandleCreateAssetsGroup)
-	v1.POST("assetsGroup/asset", ph.HandleCreateAsset)
+	v1.GET("openapi.json", HandleGetOpenApiSpec)
+	v1.POST("assetsGroup", ih.HandleIdempotencyKey, ph.HandleCreateAssetsGroup)
+	v1.POST("assetsGroup/asset", ih.HandleIdempotencyKey, ph.HandleCreateAsset)
 	v1.PUT("assetsGroup/contributionTotal", ph.HandleUpdateAssetsGroup)
 	v1.PUT("assetsGroup/asset", ph.HandleUpdateAsset)
 	v1.DELETE("assetsGroup/asset", ph.HandleDeleteAsset)
 	v1.DELETE("assetsGroup", ph.HandleDeleteAssetsGroup)
+	v1.GET("assetsGroup/trash", ph.HandleGetDeletedAssetsGroups)
+	v1.POST("assetsGroup/restore", ph.HandleRestoreAssetsGroup)
+	v1.POST("assetsGroup/asset/restore", ph.HandleRestoreAsset)
+	v1.POST("assetsGroup/revaluate", ih.HandleIdempotencyKey, ph.HandleRevaluateAssetsGroups)
 	v1.GET("assetsGroup", ph.HandleGetAssetsGroups)
 	v1.GET("assetsGroup/:id", ph.HandleGetAssetsGroup)
+	v1.GET("assetsGroup/:id/stream", gsh.HandleStreamAssetsGroup)
+	v1.GET("assetsGroup/:id/trash", ph.HandleGetDeletedAssets)
+	v1.POST("assetsGroup/:id/batch", ih.HandleIdempotencyKey, ph.HandleApplyBatch)
+
+	v1.POST("assetsGroup/schedule", sh.HandleCreateContributionSchedule)
+	v1.DELETE("assetsGroup/schedule", sh.HandleDeleteContributionSchedule)
+	v1.GET("assetsGroup/:id/plans", sh.HandleGetRebalancePlans)
+	v1.PUT("assetsGroup/plan/approve", ih.HandleIdempotencyKey, sh.HandleApproveRebalancePlan)
+	v1.PUT("assetsGroup/plan/reject", sh.HandleRejectRebalancePlan)
+
+	v1.POST("assetsGroup/subscription", nh.HandleCreateSubscription)
+	v1.DELETE("assetsGroup/subscription", nh.HandleDeleteSubscription)
+	v1.GET("assetsGroup/:id/subscriptions", nh.HandleGetSubscriptions)
+	v1.GET("assetsGroup/:id/deliveries", nh.HandleGetDeliveries)
+
+	v1.GET("assetsGroup/:id/audit", ah.HandleGetAuditTrail)
+
+	v1.POST("graphql", gh.HandleGraphQl)
+
+	v2 := eng.Group("v2")
+	v2.GET("groups", v2h.HandleGetGroups)
+	v2.GET("groups/trash", v2h.HandleGetDeletedGroups)
+	v2.POST("groups", ih.HandleIdempotencyKey, v2h.HandleCreateGroup)
+	v2.GET("groups/:groupId", v2h.HandleGetGroup)
+	v2.PATCH("groups/:groupId", v2h.HandleUpdateGroup)
+	v2.DELETE("groups/:groupId", v2h.HandleDeleteGroup)
+	v2.POST("groups/:groupId/restore", v2h.HandleRestoreGroup)
+	v2.POST("groups/:groupId/clone", ih.HandleIdempotencyKey, v2h.HandleCloneGroup)
+	v2.GET("groups/:groupId/trash", v2h.HandleGetDeletedAssets)
+	v2.GET("groups/:groupId/assets", v2h.HandleGetAssets)
+	v2.POST("groups/:groupId/assets", ih.HandleIdempotencyKey, v2h.HandleCreateAsset)
+	v2.GET("groups/:groupId/assets/:assetId", v2h.HandleGetAsset)
+	v2.PATCH("groups/:groupId/assets/:assetId", v2h.HandleUpdateAsset)
+	v2.DELETE("groups/:groupId/assets/:assetId", v2h.HandleDeleteAsset)
+	v2.POST("groups/:groupId/assets/:assetId/restore", v2h.HandleRestoreAsset)
+	v2.GET("groups/:groupId/glidePath", v2h.HandlePreviewGlidePath)
+	v2.PUT("groups/:groupId/assets/:assetId/glidePath", v2h.HandleSetGlidePath)
+	v2.DELETE("groups/:groupId/assets/:assetId/glidePath", v2h.HandleDeleteGlidePath)
+	v2.PUT("groups/:groupId/assets/:assetId/constraints", v2h.HandleSetAssetConstraints)
+	v2.DELETE("groups/:groupId/assets/:assetId/constraints", v2h.HandleDeleteAssetConstraints)
+	v2.PUT("groups/:groupId/assets/:assetId/tradingCosts", v2h.HandleSetTradingCosts)
+	v2.DELETE("groups/:groupId/assets/:assetId/tradingCosts", v2h.HandleDeleteTradingCosts)
+	v2.POST("groups/:groupId/assets/:assetId/lots", ih.HandleIdempotencyKey, v2h.HandleAddTaxLot)
+	v2.DELETE("groups/:groupId/assets/:assetId/lots/:lotId", v2h.HandleDeleteTaxLot)
+
+	v2.GET("templates", th.HandleGetTemplates)
+	v2.POST("templates", ih.HandleIdempotencyKey, th.HandleCreateTemplate)
+	v2.GET("templates/:templateId", th.HandleGetTemplate)
+	v2.PUT("templates/:templateId", th.HandleUpdateTemplate)
+	v2.DELETE("templates/:templateId", th.HandleDeleteTemplate)
+	v2.POST("templates/:templateId/groups", ih.HandleIdempotencyKey, th.HandleInstantiateTemplate)
 }
@@ -0,0 +1,64 @@
+package adapters
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+)
+
+type (
+	openApiDocument struct {
+		Paths map[string]map[string]interface{}
+	}
+)
+
+func Test_Should_DocumentEveryRouteInOpenApiSpec(t *testing.T) {
+	assert := assert.New(t)
+	eng := gin.New()
+	ConfigureRouter(eng, &AssetsBalancerHandler{}, &ContributionScheduleHandler{},
+		&NotificationHandler{}, &AuditHandler{}, &AssetsBalancerV2Handler{}, &GraphQlHandler{}, &GroupStreamHandler{}, &HealthHandler{}, &MetricsHandler{}, &IdempotencyHandler{}, &PortfolioTemplateHandler{})
+	w := httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/v1/openapi.json", nil))
+	doc := &openApiDocument{}
+	if !assert.Equal(http.StatusOK, w.Code) ||
+		!assert.Nil(json.Unmarshal(w.Body.Bytes(), doc)) {
+		t.FailNow()
+	}
+
+	for _, r := range eng.Routes() {
+		segments := strings.Split(r.Path, "/")
+		for i, s := range segments {
+			if strings.HasPrefix(s, ":") {
+				segments[i] = "{" + s[1:] + "}"
+			}
+		}
+		path := strings.Join(segments, "/")
+		assert.Contains(doc.Paths[path], strings.ToLower(r.Method), r.Method+" "+path)
+	}
+}
+
+func Test_Should_ReportFieldValidationErrors(t *testing.T) {
+	assert := assert.New(t)
+	eng := gin.New()
+	ConfigureRouter(eng, &AssetsBalancerHandler{}, &ContributionScheduleHandler{},
+		&NotificationHandler{}, &AuditHandler{}, &AssetsBalancerV2Handler{}, &GraphQlHandler{}, &GroupStreamHandler{}, &HealthHandler{}, &MetricsHandler{}, &IdempotencyHandler{}, &PortfolioTemplateHandler{})
+	body := `{"Label": "", "Assets": [{"Label": "RF", "Score": 120}]}`
+
+	w := httptest.NewRecorder()
+	eng.ServeHTTP(w, httptest.NewRequest(http.MethodPost, "/v1/assetsGroup", strings.NewReader(body)))
+	res := &errorResult{}
+
+	if !assert.Equal(http.StatusUnprocessableEntity, w.Code) ||
+		!assert.Nil(json.Unmarshal(w.Body.Bytes(), res)) ||
+		!assert.ElementsMatch([]*fieldError{
+			{Field: "Assets[0].Score", Rule: "lte", Message: "must be less than or equal to 100"},
+			{Field: "Label", Rule: "required", Message: "is required"},
+		}, res.Fields) {
+		t.FailNow()
+	}
+}
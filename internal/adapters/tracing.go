@@ -0,0 +1,105 @@
+package adapters
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/spf13/viper"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracingServiceName = "assets-balancer"
+
+var tracer = otel.Tracer("github.com/romaopatrick/assets-balancer/internal/adapters")
+
+const (
+	groupIdAttribute    = attribute.Key("assets_balancer.group.id")
+	assetCountAttribute = attribute.Key("assets_balancer.asset.count")
+)
+
+// NewTracerProvider installs the global tracer provider with the exporter
+// named by "tracing.exporter": "otlp" sends spans over gRPC to
+// "tracing.otlp.endpoint", "stdout" prints them, and "none" or nothing
+// records no spans.
+func NewTracerProvider(cfg *viper.Viper) *sdktrace.TracerProvider {
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceName(tracingServiceName),
+			semconv.ServiceVersion(Version))),
+	}
+	ratio := 1.
+	if cfg.IsSet("tracing.sampleRatio") {
+		ratio = cfg.GetFloat64("tracing.sampleRatio")
+	}
+	opts = append(opts, sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))))
+
+	switch exporter := cfg.GetString("tracing.exporter"); exporter {
+	case "", "none":
+	case "stdout":
+		exp, err := stdouttrace.New(stdouttrace.WithWriter(os.Stdout), stdouttrace.WithPrettyPrint())
+		if err != nil {
+			panic(err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exp))
+	case "otlp":
+		clientOpts := []otlptracegrpc.Option{
+			otlptracegrpc.WithEndpoint(cfg.GetString("tracing.otlp.endpoint")),
+		}
+		if cfg.GetBool("tracing.otlp.insecure") {
+			clientOpts = append(clientOpts, otlptracegrpc.WithInsecure())
+		}
+		exp, err := otlptracegrpc.New(context.Background(), clientOpts...)
+		if err != nil {
+			panic(err)
+		}
+		opts = append(opts, sdktrace.WithBatcher(exp))
+	default:
+		panic(fmt.Sprintf("unknown tracing exporter %q", exporter))
+	}
+
+	tp := sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+
+	return tp
+}
+
+// TracingMiddleware starts a span for every request, continuing the
+// trace of the caller if it sent one. Probes and scrapes are left out.
+func TracingMiddleware() gin.HandlerFunc {
+	return otelgin.Middleware(tracingServiceName, otelgin.WithFilter(func(r *http.Request) bool {
+		switch r.URL.Path {
+		case "/healthz", "/readyz", "/metrics":
+			return false
+		}
+		return true
+	}))
+}
+
+// groupAttributes describes the group a span works on.
+func groupAttributes(group *domain.AssetsGroup) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		groupIdAttribute.String(group.Id.String()),
+		assetCountAttribute.Int(len(group.Assets)),
+	}
+}
+
+// startSpan is a shorthand for the package tracer.
+func startSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return tracer.Start(ctx, name, trace.WithAttributes(attrs...))
+}
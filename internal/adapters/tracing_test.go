@@ -0,0 +1,47 @@
+package adapters
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func Test_Should_TraceRebalanceUnderTheRequestSpan(t *testing.T) {
+	assert := assert.New(t)
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+	group := domain.NewAssetGroup("test", []*domain.Asset{
+		domain.NewAsset("RF", 100, 0, 100, 100, 0, true),
+	}, 100)
+	eng := gin.New()
+	eng.ContextWithFallback = true
+	eng.Use(TracingMiddleware())
+	eng.GET("/v2/groups/:groupId", func(c *gin.Context) {
+		balance(c, group)
+		c.Status(http.StatusOK)
+	})
+
+	eng.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/v2/groups/1", nil))
+	eng.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	spans := recorder.Ended()
+	if !assert.Len(spans, 2) {
+		t.FailNow()
+	}
+	rebalance, request := spans[0], spans[1]
+	assert.Equal("rebalance", rebalance.Name())
+	assert.Equal("/v2/groups/:groupId", request.Name())
+	assert.Equal(request.SpanContext().SpanID(), rebalance.Parent().SpanID())
+	assert.Contains(rebalance.Attributes(), groupIdAttribute.String(group.Id.String()))
+	assert.Contains(rebalance.Attributes(), assetCountAttribute.Int(1))
+}
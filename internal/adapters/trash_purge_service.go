@@ -0,0 +1,121 @@
+package adapters
+
+import (
+	"context"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+	"github.com/romaopatrick/assets-balancer/internal/ports"
+
+	"github.com/spf13/viper"
+	"golang.org/x/exp/slog"
+)
+
+type (
+	TrashPurgeService struct {
+		repository ports.Repository[*domain.AssetsGroup]
+		journal    *ChangeJournal
+		retention  time.Duration
+		now        func() time.Time
+	}
+	TrashPurgeJob struct {
+		useCase  ports.TrashPurgeUseCase
+		interval time.Duration
+	}
+)
+
+// NewTrashPurgeUseCase reads "trash.retentionDays", how long deleted
+// groups and assets are kept before they're purged. Zero keeps them
+// until restored.
+func NewTrashPurgeUseCase(
+	cfg *viper.Viper,
+	repository ports.Repository[*domain.AssetsGroup],
+	journal *ChangeJournal) ports.TrashPurgeUseCase {
+	return &TrashPurgeService{
+		repository: repository,
+		journal:    journal,
+		retention:  time.Duration(cfg.GetInt("trash.retentionDays")) * 24 * time.Hour,
+		now:        time.Now,
+	}
+}
+
+// PurgeTrash deletes for good the groups and assets that have been in
+// the trash for longer than the retention, returning how many. Each
+// group is purged with its events and audit entries; one restored or
+// changed meanwhile is left for the next run.
+func (tps *TrashPurgeService) PurgeTrash(ctx context.Context) int {
+	if tps.retention <= 0 {
+		return 0
+	}
+	cutoff := tps.now().Add(-tps.retention)
+	expired := map[string]interface{}{
+		"$lt": cutoff,
+	}
+
+	purged := 0
+	for _, g := range tps.repository.GetAll(ctx, map[string]interface{}{
+		"deletedat": expired,
+	}) {
+		g.Purge()
+		deleted := int64(0)
+		err := tps.journal.Save(ctx, g, func(ctx context.Context) error {
+			deleted = tps.repository.DeleteAll(ctx, map[string]interface{}{
+				"id":        g.Id,
+				"deletedat": expired,
+			})
+			if deleted == 0 {
+				return domain.ErrAssetsGroupConflict
+			}
+			return nil
+		})
+		logMutation(ctx, "PurgeAssetsGroup", err, "group_id", g.Id)
+		if err == nil {
+			purged += int(deleted)
+		}
+	}
+	for _, g := range tps.repository.GetAll(ctx, notDeleted(map[string]interface{}{
+		"deletedassets.deletedat": expired,
+	})) {
+		count := g.PurgeAssets(cutoff)
+		err := tps.journal.Save(ctx, g, func(ctx context.Context) error {
+			return replaceGroup(ctx, tps.repository, g)
+		})
+		logMutation(ctx, "PurgeAssets", err, "group_id", g.Id)
+		if err == nil {
+			purged += count
+		}
+	}
+	if purged > 0 {
+		slog.InfoCtx(ctx, "trash purged", "items", purged, "cutoff", cutoff)
+	}
+
+	return purged
+}
+
+func NewTrashPurgeJob(
+	uc ports.TrashPurgeUseCase,
+	cfg *viper.Viper) *TrashPurgeJob {
+	return &TrashPurgeJob{
+		useCase:  uc,
+		interval: cfg.GetDuration("trash.purgeInterval"),
+	}
+}
+
+// Start purges the trash once, then on every interval until ctx is
+// done. A zero interval disables the job.
+func (j *TrashPurgeJob) Start(ctx context.Context) {
+	if j.interval <= 0 {
+		return
+	}
+	j.useCase.PurgeTrash(ctx)
+	t := time.NewTicker(j.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+			j.useCase.PurgeTrash(ctx)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package adapters
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Should_PurgeWhatOutlivedTheRetention(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Date(2023, 3, 31, 12, 0, 0, 0, time.UTC)
+	expired, recent := now.AddDate(0, 0, -31), now.AddDate(0, 0, -1)
+	deleted := domain.NewAssetGroup("deleted", []*domain.Asset{}, 100)
+	deleted.DeletedAt = &expired
+	restored := domain.NewAssetGroup("restored", []*domain.Asset{}, 100)
+	restored.DeletedAt = &expired
+	old := domain.NewAsset("old", 0, 0, 0, 0, 0, true)
+	old.DeletedAt = &expired
+	young := domain.NewAsset("young", 0, 0, 0, 0, 0, true)
+	young.DeletedAt = &recent
+	active := domain.NewAssetGroup("active", []*domain.Asset{}, 100)
+	active.DeletedAssets = []*domain.Asset{old, young}
+	active.Version = 3
+	r := newMockedRepository[*domain.AssetsGroup]()
+	r.mockGetAll = func(filter map[string]interface{}) []*domain.AssetsGroup {
+		if _, ok := filter["deletedassets.deletedat"]; ok {
+			return []*domain.AssetsGroup{active}
+		}
+		return []*domain.AssetsGroup{deleted, restored}
+	}
+	deletedFilters := []map[string]interface{}{}
+	r.mockDeleteAll = func(filter map[string]interface{}) int64 {
+		deletedFilters = append(deletedFilters, filter)
+		if filter["id"] == restored.Id {
+			// restored after it was read
+			return 0
+		}
+		return 1
+	}
+	replacedFilters := []map[string]interface{}{}
+	r.mockReplace = func(filter map[string]interface{}, entity *domain.AssetsGroup) {
+		replacedFilters = append(replacedFilters, filter)
+	}
+	outbox := newMockedInsertingRepository[*domain.DomainEvent]()
+	audit := newMockedInsertingRepository[*domain.AuditEntry]()
+	cfg := viper.New()
+	cfg.Set("trash.retentionDays", 30)
+	uc := NewTrashPurgeUseCase(cfg, r, NewChangeJournal(&mockedTransactor{}, outbox, audit,
+		NewInProcessGroupUpdateBroker())).(*TrashPurgeService)
+	uc.now = func() time.Time { return now }
+
+	purged := uc.PurgeTrash(context.Background())
+
+	assert.Equal(2, purged)
+	assert.Equal([]map[string]interface{}{
+		{"id": deleted.Id, "deletedat": map[string]interface{}{"$lt": now.AddDate(0, 0, -30)}},
+		{"id": restored.Id, "deletedat": map[string]interface{}{"$lt": now.AddDate(0, 0, -30)}},
+	}, deletedFilters)
+	assert.Equal([]map[string]interface{}{{"id": active.Id, "version": int64(3)}}, replacedFilters)
+	assert.Equal([]*domain.Asset{young}, active.DeletedAssets)
+	if !assert.Len(outbox.mockedDatabase, 2) || !assert.Len(audit.mockedDatabase, 2) {
+		t.FailNow()
+	}
+	assert.Equal(domain.EVENT_ASSETS_GROUP_PURGED, outbox.mockedDatabase[0].Type)
+	assert.Equal(deleted.Id, outbox.mockedDatabase[0].AggregateId)
+	assert.Equal(domain.EVENT_ASSET_PURGED, outbox.mockedDatabase[1].Type)
+	assert.Equal(domain.AUDIT_PURGE_ASSETS_GROUP, audit.mockedDatabase[0].Operation)
+	assert.Equal(domain.AUDIT_PURGE_ASSET, audit.mockedDatabase[1].Operation)
+	assert.Equal(old.Id, audit.mockedDatabase[1].AssetId)
+}
+
+func Test_Should_Not_PurgeWithoutRetention(t *testing.T) {
+	assert := assert.New(t)
+	r := newMockedRepository[*domain.AssetsGroup]()
+
+	purged := NewTrashPurgeUseCase(viper.New(), r, newMockedChangeJournal()).PurgeTrash(context.Background())
+
+	assert.Zero(purged)
+}
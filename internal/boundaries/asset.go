@@ -1,48 +1,129 @@
 package boundaries
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type (
 	CreateAssetsGroupInput struct {
-		Assets            []CreateAssetInput
-		Label             string
-		ContributionTotal float64
+		Assets            []CreateAssetInput `binding:"dive"`
+		Label             string             `binding:"required"`
+		ContributionTotal float64            `binding:"gte=0"`
 	}
 	CreateAssetInput struct {
-		Label         string
-		Score         float32
-		PreviousValue float64
-		CurrentValue  float64
+		Label         string `binding:"required"`
+		Ticker        string
+		Quantity      float64 `binding:"gte=0"`
+		Score         float32 `binding:"gte=0,lte=100"`
+		PreviousValue float64 `binding:"gte=0"`
+		CurrentValue  float64 `binding:"gte=0"`
 		Include       bool
 	}
 	CreateAssetForGroupInput struct {
-		GroupId       uuid.UUID
-		Label         string
-		Score         float32
-		PreviousValue float64
-		CurrentValue  float64
+		GroupId       uuid.UUID `binding:"required"`
+		Label         string    `binding:"required"`
+		Ticker        string
+		Quantity      float64 `binding:"gte=0"`
+		Score         float32 `binding:"gte=0,lte=100"`
+		PreviousValue float64 `binding:"gte=0"`
+		CurrentValue  float64 `binding:"gte=0"`
 		Include       bool
 	}
+	// UpdateAssetInput and UpdateAssetsGroup are partial updates: a nil
+	// field, whether left out of the body or sent as null, is unchanged.
 	UpdateAssetInput struct {
-		Id            uuid.UUID
-		GroupId       uuid.UUID
-		Label         string
-		Score         float32
-		PreviousValue float64
-		CurrentValue  float64
-		Include       bool
+		Id            uuid.UUID `binding:"required"`
+		GroupId       uuid.UUID `binding:"required"`
+		Label         *string   `binding:"omitempty,min=1"`
+		Ticker        *string
+		Quantity      *float64 `binding:"omitempty,gte=0"`
+		Score         *float32 `binding:"omitempty,gte=0,lte=100"`
+		PreviousValue *float64 `binding:"omitempty,gte=0"`
+		CurrentValue  *float64 `binding:"omitempty,gte=0"`
+		Include       *bool
 	}
 	UpdateAssetsGroup struct {
-		Id                uuid.UUID
-		ContributionTotal float64
-		Label             string
+		Id                uuid.UUID `binding:"required"`
+		ContributionTotal *float64  `binding:"omitempty,gte=0"`
+		Label             *string   `binding:"omitempty,min=1"`
+		RebalanceMode     *string   `binding:"omitempty,oneof=PROPORTIONAL OPTIMIZED"`
+		LotSelection      *string   `binding:"omitempty,oneof=FIFO LIFO HIGHEST_COST LOWEST_COST"`
+		TaxAware          *bool
 	}
 	DeleteAssetInput struct {
-		Id      uuid.UUID
-		GroupId uuid.UUID
+		Id      uuid.UUID `binding:"required"`
+		GroupId uuid.UUID `binding:"required"`
 	}
 	DeleteAssetsGroupInput struct {
-		Id uuid.UUID
+		Id uuid.UUID `binding:"required"`
+	}
+	RestoreAssetInput struct {
+		Id      uuid.UUID `binding:"required"`
+		GroupId uuid.UUID `binding:"required"`
+	}
+	RestoreAssetsGroupInput struct {
+		Id uuid.UUID `binding:"required"`
+	}
+	SetGlidePathInput struct {
+		Id            uuid.UUID             `binding:"required"`
+		GroupId       uuid.UUID             `binding:"required"`
+		Interpolation string                `binding:"omitempty,oneof=LINEAR STEP"`
+		Points        []GlidePathPointInput `binding:"required,min=1,dive"`
+	}
+	GlidePathPointInput struct {
+		At    time.Time `binding:"required"`
+		Score float32   `binding:"gte=0,lte=100"`
+	}
+	DeleteGlidePathInput struct {
+		Id      uuid.UUID `binding:"required"`
+		GroupId uuid.UUID `binding:"required"`
+	}
+	SetAssetConstraintsInput struct {
+		Id        uuid.UUID `binding:"required"`
+		GroupId   uuid.UUID `binding:"required"`
+		MinWeight *float32  `binding:"omitempty,gte=0,lte=100"`
+		MaxWeight *float32  `binding:"omitempty,gte=0,lte=100"`
+		MinTrade  float64   `binding:"gte=0"`
+		NeverSell bool
+		NeverBuy  bool
+	}
+	DeleteAssetConstraintsInput struct {
+		Id      uuid.UUID `binding:"required"`
+		GroupId uuid.UUID `binding:"required"`
+	}
+	SetTradingCostsInput struct {
+		Id               uuid.UUID `binding:"required"`
+		GroupId          uuid.UUID `binding:"required"`
+		FixedCost        float64   `binding:"gte=0"`
+		ProportionalCost float64   `binding:"gte=0,lt=1"`
+		LotSize          float64   `binding:"gte=0"`
+	}
+	DeleteTradingCostsInput struct {
+		Id      uuid.UUID `binding:"required"`
+		GroupId uuid.UUID `binding:"required"`
+	}
+	AddTaxLotInput struct {
+		AssetId    uuid.UUID `binding:"required"`
+		GroupId    uuid.UUID `binding:"required"`
+		Quantity   float64   `binding:"gt=0"`
+		CostBasis  float64   `binding:"gte=0"`
+		AcquiredAt time.Time `binding:"required"`
+	}
+	DeleteTaxLotInput struct {
+		Id      uuid.UUID `binding:"required"`
+		AssetId uuid.UUID `binding:"required"`
+		GroupId uuid.UUID `binding:"required"`
+	}
+	// PreviewGlidePathInput asks for the targets of a group every
+	// StepMonths from From to To. Zero values mean from now, up to the end
+	// of its glide paths, once a year.
+	PreviewGlidePathInput struct {
+		GroupId    uuid.UUID
+		From       time.Time `form:"from" time_format:"2006-01-02"`
+		To         time.Time `form:"to" time_format:"2006-01-02"`
+		StepMonths int       `form:"stepMonths" binding:"gte=0"`
 	}
 
 	GetAssetsGroupInput struct {
@@ -0,0 +1,22 @@
+package boundaries
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	GetAuditTrailInput struct {
+		GroupId uuid.UUID
+		Actor   string    `form:"actor"`
+		From    time.Time `form:"from" time_format:"2006-01-02T15:04:05Z07:00"`
+		To      time.Time `form:"to" time_format:"2006-01-02T15:04:05Z07:00"`
+	}
+	GetAuditTrailsInput struct {
+		GroupIds []uuid.UUID
+		Actor    string
+		From     time.Time
+		To       time.Time
+	}
+)
@@ -0,0 +1,69 @@
+package boundaries
+
+import (
+	"github.com/google/uuid"
+)
+
+const (
+	BATCH_OPERATION_ADD     = "add"
+	BATCH_OPERATION_UPDATE  = "update"
+	BATCH_OPERATION_REMOVE  = "remove"
+	BATCH_OPERATION_INCLUDE = "include"
+)
+
+type (
+	BatchAssetsInput struct {
+		GroupId    uuid.UUID         `binding:"required"`
+		Operations []*BatchOperation `binding:"required,min=1,dive"`
+	}
+	// BatchOperation adds an asset from its fields, updates the given ones
+	// on AssetId, removes AssetId, or sets Include on AssetId.
+	BatchOperation struct {
+		Op            string `binding:"required,oneof=add update remove include"`
+		AssetId       uuid.UUID
+		Label         *string `binding:"omitempty,min=1"`
+		Ticker        *string
+		Quantity      *float64 `binding:"omitempty,gte=0"`
+		Score         *float32 `binding:"omitempty,gte=0,lte=100"`
+		PreviousValue *float64 `binding:"omitempty,gte=0"`
+		CurrentValue  *float64 `binding:"omitempty,gte=0"`
+		Include       *bool
+	}
+
+	// BatchAssetsResult tells whether the batch was applied and how each
+	// operation went. Group is the saved group, only when applied.
+	BatchAssetsResult struct {
+		Applied bool
+		Results []*BatchOperationResult
+		Group   *BatchAssetsGroup `json:",omitempty"`
+	}
+	// BatchAssetsGroup is the group as the batch left it, balanced.
+	BatchAssetsGroup struct {
+		Id                      uuid.UUID
+		Label                   string
+		ContributionTotal       float64
+		UnallocatedContribution float64 `json:",omitempty"`
+		Owner                   string
+		Version                 int64
+		Assets                  []*BatchAsset
+	}
+	BatchAsset struct {
+		Id                  uuid.UUID
+		Label               string
+		Ticker              string
+		Quantity            float64
+		Score               float32
+		PreviousValue       float64
+		CurrentValue        float64
+		ValueVariation      float64
+		PercentageFromTotal float64
+		FinalContribution   float64
+		Include             bool
+	}
+	BatchOperationResult struct {
+		Index   int
+		Op      string
+		AssetId uuid.UUID
+		Error   string `json:",omitempty"`
+	}
+)
@@ -0,0 +1,22 @@
+package boundaries
+
+import "github.com/google/uuid"
+
+type (
+	CreateContributionScheduleInput struct {
+		GroupId uuid.UUID `binding:"required"`
+		Cron    string    `binding:"required"`
+		Amount  float64   `binding:"gt=0"`
+	}
+	DeleteContributionScheduleInput struct {
+		Id      uuid.UUID `binding:"required"`
+		GroupId uuid.UUID `binding:"required"`
+	}
+	GetRebalancePlansInput struct {
+		GroupId uuid.UUID
+		Status  string
+	}
+	ReviewRebalancePlanInput struct {
+		Id uuid.UUID `binding:"required"`
+	}
+)
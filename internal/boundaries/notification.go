@@ -0,0 +1,23 @@
+package boundaries
+
+import "github.com/google/uuid"
+
+type (
+	CreateNotificationSubscriptionInput struct {
+		GroupId        uuid.UUID `binding:"required"`
+		Channel        string    `binding:"required,oneof=WEBHOOK EMAIL"`
+		Target         string    `binding:"required"`
+		Secret         string
+		Events         []string `binding:"required,min=1,dive,oneof=ASSET_DRIFTED PLAN_GENERATED GROUP_MODIFIED"`
+		DriftThreshold float64  `binding:"gte=0"`
+	}
+	DeleteNotificationSubscriptionInput struct {
+		Id uuid.UUID `binding:"required"`
+	}
+	GetNotificationSubscriptionsInput struct {
+		GroupId uuid.UUID
+	}
+	GetNotificationDeliveriesInput struct {
+		GroupId uuid.UUID
+	}
+)
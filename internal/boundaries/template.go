@@ -0,0 +1,43 @@
+package boundaries
+
+import "github.com/google/uuid"
+
+type (
+	CreatePortfolioTemplateInput struct {
+		Label       string `binding:"required"`
+		Description string
+		Tags        []string
+		Assets      []TemplateAssetInput `binding:"required,min=1,dive"`
+	}
+	// UpdatePortfolioTemplateInput replaces the template. Assets sent with
+	// their id keep the link to the groups made from the template.
+	UpdatePortfolioTemplateInput struct {
+		Id          uuid.UUID `binding:"required"`
+		Label       string    `binding:"required"`
+		Description string
+		Tags        []string
+		Assets      []TemplateAssetInput `binding:"required,min=1,dive"`
+	}
+	TemplateAssetInput struct {
+		Id      uuid.UUID
+		Label   string `binding:"required"`
+		Ticker  string
+		Score   float32 `binding:"gte=0,lte=100"`
+		Include bool
+	}
+	DeletePortfolioTemplateInput struct {
+		Id uuid.UUID `binding:"required"`
+	}
+	GetPortfolioTemplateInput struct {
+		Id uuid.UUID
+	}
+	InstantiatePortfolioTemplateInput struct {
+		TemplateId        uuid.UUID `binding:"required"`
+		Label             string    `binding:"required"`
+		ContributionTotal float64   `binding:"gte=0"`
+	}
+	CloneAssetsGroupInput struct {
+		Id    uuid.UUID `binding:"required"`
+		Label string    `binding:"required"`
+	}
+)
@@ -1,17 +1,54 @@
 package domain
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type (
 	AssetsGroup struct {
-		Id                uuid.UUID
-		Assets            []*Asset
-		Label             string
-		ContributionTotal float64
+		Id                    uuid.UUID
+		Assets                []*Asset
+		Label                 string
+		ContributionTotal     float64
+		ContributionSchedules []*ContributionSchedule
+		// Owner is the principal that created the group.
+		Owner string
+		// DeletedAt is set while the group is in the trash.
+		DeletedAt *time.Time `json:",omitempty"`
+		// DeletedAssets is the trash of the group, the assets removed from
+		// it that haven't been purged yet.
+		DeletedAssets []*Asset `json:"-"`
+		// TemplateId links the group to the template it was made from.
+		TemplateId *uuid.UUID `json:",omitempty"`
+		// UnallocatedContribution is the part of the contribution the
+		// constraints of the assets kept from being distributed.
+		UnallocatedContribution float64 `json:",omitempty"`
+		// RebalanceMode tells how the contribution is distributed,
+		// proportionally to the scores unless OPTIMIZED.
+		RebalanceMode string              `json:",omitempty"`
+		Optimization  *OptimizationResult `json:",omitempty"`
+		// TaxAware holds back the sales that would realize gains, in
+		// either mode.
+		TaxAware bool `json:",omitempty"`
+		// LotSelection is the order the lots of the assets are sold in,
+		// FIFO unless set.
+		LotSelection          string  `json:",omitempty"`
+		EstimatedRealizedGain float64 `json:",omitempty"`
+		// Version counts the saves of the group, so a save made from a
+		// stale copy is told apart instead of overwriting the newer one.
+		Version int64
+
+		events    []*DomainEvent
+		eventsErr error
+		audit     []*AuditEntry
 	}
 	Asset struct {
 		Id                  uuid.UUID
 		Label               string
+		Ticker              string
+		Quantity            float64
 		Score               float32
 		PreviousValue       float64
 		CurrentValue        float64
@@ -19,6 +56,22 @@ type (
 		PercentageFromTotal float64
 		FinalContribution   float64
 		Include             bool
+		DeletedAt           *time.Time        `json:",omitempty"`
+		TemplateAssetId     *uuid.UUID        `json:",omitempty"`
+		Constraints         *AssetConstraints `json:",omitempty"`
+		// Binding explains why the final contribution falls short of the
+		// score, when a constraint held it back.
+		Binding      *ConstraintBinding `json:",omitempty"`
+		TradingCosts *TradingCosts      `json:",omitempty"`
+		// TransactionCost is what the final contribution costs to trade,
+		// when the group is optimized.
+		TransactionCost float64   `json:",omitempty"`
+		TaxLots         []*TaxLot `json:",omitempty"`
+		// SaleEstimate is what selling the final contribution realizes,
+		// when it's negative.
+		SaleEstimate *SaleEstimate `json:",omitempty"`
+		// GlidePath, when set, moves the score over time.
+		GlidePath *GlidePath `json:",omitempty"`
 	}
 )
 
@@ -38,6 +91,22 @@ func (a *Asset) CalculateFinalContribution(contributionTotal, currentTotal float
 	return ((currentTotal + contributionTotal) * float64(a.Score) / 100) - a.CurrentValue
 }
 
+// Revalue rolls the current value into the previous one and prices the
+// held quantity at the given unit price. An asset without a quantity
+// keeps the value it was given, since there's nothing to price, and so
+// does one given a price that isn't positive.
+func (a *Asset) Revalue(price float64) error {
+	if price <= 0 {
+		return ErrInvalidPrice
+	}
+	if a.Quantity <= 0 {
+		return nil
+	}
+	a.PreviousValue = a.CurrentValue
+	a.CurrentValue = a.Quantity * price
+	return nil
+}
+
 func (ag *AssetsGroup) CurrentTotal() float64 {
 	result := 0.
 	for _, v := range ag.Assets {
@@ -74,3 +143,84 @@ func NewAssetGroup(label string, assets []*Asset, contributionT float64) *Assets
 		ContributionTotal: contributionT,
 	}
 }
+
+// Clone copies the group along with its assets and everything they hold,
+// so the copy can be changed without touching the original.
+func (ag *AssetsGroup) Clone() *AssetsGroup {
+	clone := *ag
+	clone.Assets = cloneAssets(ag.Assets)
+	if ag.DeletedAssets != nil {
+		clone.DeletedAssets = cloneAssets(ag.DeletedAssets)
+	}
+	if ag.ContributionSchedules != nil {
+		clone.ContributionSchedules = make([]*ContributionSchedule, len(ag.ContributionSchedules))
+		for i, cs := range ag.ContributionSchedules {
+			schedule := *cs
+			clone.ContributionSchedules[i] = &schedule
+		}
+	}
+	if ag.Optimization != nil {
+		optimization := *ag.Optimization
+		clone.Optimization = &optimization
+	}
+	clone.events = append([]*DomainEvent(nil), ag.events...)
+	clone.audit = append([]*AuditEntry(nil), ag.audit...)
+
+	return &clone
+}
+
+// Clone copies the asset along with its constraints, costs, glide path
+// and tax lots.
+func (a *Asset) Clone() *Asset {
+	clone := *a
+	if a.Constraints != nil {
+		constraints := *a.Constraints
+		constraints.MinWeight = clonePtr(a.Constraints.MinWeight)
+		constraints.MaxWeight = clonePtr(a.Constraints.MaxWeight)
+		clone.Constraints = &constraints
+	}
+	clone.Binding = clonePtr(a.Binding)
+	clone.TradingCosts = clonePtr(a.TradingCosts)
+	if a.TaxLots != nil {
+		clone.TaxLots = make([]*TaxLot, len(a.TaxLots))
+		for i, tl := range a.TaxLots {
+			clone.TaxLots[i] = clonePtr(tl)
+		}
+	}
+	if a.SaleEstimate != nil {
+		estimate := *a.SaleEstimate
+		estimate.Lots = make([]*LotSale, len(a.SaleEstimate.Lots))
+		for i, ls := range a.SaleEstimate.Lots {
+			estimate.Lots[i] = clonePtr(ls)
+		}
+		clone.SaleEstimate = &estimate
+	}
+	if a.GlidePath != nil {
+		path := *a.GlidePath
+		path.Points = make([]*GlidePathPoint, len(a.GlidePath.Points))
+		for i, p := range a.GlidePath.Points {
+			path.Points[i] = clonePtr(p)
+		}
+		clone.GlidePath = &path
+	}
+	clone.DeletedAt = clonePtr(a.DeletedAt)
+	clone.TemplateAssetId = clonePtr(a.TemplateAssetId)
+
+	return &clone
+}
+
+func cloneAssets(assets []*Asset) []*Asset {
+	clones := make([]*Asset, len(assets))
+	for i, a := range assets {
+		clones[i] = a.Clone()
+	}
+	return clones
+}
+
+func clonePtr[T any](v *T) *T {
+	if v == nil {
+		return nil
+	}
+	clone := *v
+	return &clone
+}
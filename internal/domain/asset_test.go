@@ -27,3 +27,60 @@ func Test_Should_CalculateFinalContribution(t *testing.T) {
 
 	assert.EqualValues(30, a.CalculateFinalContribution(contribution, total))
 }
+func Test_Should_Revalue(t *testing.T) {
+	assert := assert.New(t)
+	a := NewAsset("test", 50, 100, 70, 100, 100, true)
+	a.Quantity = 10
+
+	a.Revalue(8.5)
+
+	if !assert.EqualValues(70, a.PreviousValue) ||
+		!assert.EqualValues(85, a.CurrentValue) {
+		t.FailNow()
+	}
+
+	a.Quantity = 0
+	a.Revalue(9)
+	assert.EqualValues(85, a.CurrentValue)
+}
+
+func Test_Should_Not_RevalueAtPricesThatArentPositive(t *testing.T) {
+	assert := assert.New(t)
+	a := NewAsset("test", 50, 100, 70, 100, 100, true)
+	a.Quantity = 10
+
+	for _, price := range []float64{0, -8.5} {
+		err := a.Revalue(price)
+
+		assert.ErrorIs(err, ErrInvalidPrice)
+		assert.EqualValues(70, a.CurrentValue)
+		assert.EqualValues(100, a.PreviousValue)
+	}
+}
+
+func Test_Should_CloneWhatTheAssetsHold(t *testing.T) {
+	assert := assert.New(t)
+	maxWeight := float32(40)
+	a := NewAsset("test", 50, 100, 100, 100, 0, true)
+	a.Constraints = &AssetConstraints{MaxWeight: &maxWeight}
+	a.TradingCosts = &TradingCosts{FixedCost: 1}
+	a.GlidePath = &GlidePath{Points: []*GlidePathPoint{{Score: 50}}}
+	a.TaxLots = []*TaxLot{{Quantity: 10}}
+	group := NewAssetGroup("test", []*Asset{a}, 0)
+	group.Record(AssetsGroupRenamed{Label: "test"})
+
+	clone := group.Clone()
+	c := clone.Assets[0]
+	*c.Constraints.MaxWeight = 60
+	c.TradingCosts.FixedCost = 2
+	c.GlidePath.Points[0].Score = 70
+	c.TaxLots[0].Quantity = 5
+	clone.Record(AssetsGroupRenamed{Label: "clone"})
+
+	assert.EqualValues(40, *a.Constraints.MaxWeight)
+	assert.EqualValues(1, a.TradingCosts.FixedCost)
+	assert.EqualValues(50, a.GlidePath.Points[0].Score)
+	assert.EqualValues(10, a.TaxLots[0].Quantity)
+	events, _ := group.PullEvents()
+	assert.Len(events, 1)
+}
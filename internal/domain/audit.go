@@ -0,0 +1,136 @@
+package domain
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	AUDIT_CREATE_ASSETS_GROUP  = "CreateAssetsGroup"
+	AUDIT_UPDATE_ASSETS_GROUP  = "UpdateAssetsGroup"
+	AUDIT_DELETE_ASSETS_GROUP  = "DeleteAssetsGroup"
+	AUDIT_CREATE_ASSET         = "CreateAsset"
+	AUDIT_UPDATE_ASSET         = "UpdateAsset"
+	AUDIT_DELETE_ASSET         = "DeleteAsset"
+	AUDIT_REVALUATE_ASSET      = "RevaluateAsset"
+	AUDIT_APPLY_REBALANCE_PLAN = "ApplyRebalancePlan"
+	AUDIT_RESTORE_ASSETS_GROUP = "RestoreAssetsGroup"
+	AUDIT_RESTORE_ASSET        = "RestoreAsset"
+	AUDIT_SYNC_TEMPLATE        = "SyncTemplate"
+	AUDIT_SET_GLIDE_PATH       = "SetGlidePath"
+	AUDIT_DELETE_GLIDE_PATH    = "DeleteGlidePath"
+	AUDIT_FOLLOW_GLIDE_PATH    = "FollowGlidePath"
+	AUDIT_SET_CONSTRAINTS      = "SetConstraints"
+	AUDIT_DELETE_CONSTRAINTS   = "DeleteConstraints"
+	AUDIT_SET_TRADING_COSTS    = "SetTradingCosts"
+	AUDIT_DELETE_TRADING_COSTS = "DeleteTradingCosts"
+	AUDIT_ADD_TAX_LOT          = "AddTaxLot"
+	AUDIT_DELETE_TAX_LOT       = "DeleteTaxLot"
+	AUDIT_PURGE_ASSETS_GROUP   = "PurgeAssetsGroup"
+	AUDIT_PURGE_ASSET          = "PurgeAsset"
+	AUDIT_CREATE_TEMPLATE      = "CreateTemplate"
+	AUDIT_UPDATE_TEMPLATE      = "UpdateTemplate"
+	AUDIT_DELETE_TEMPLATE      = "DeleteTemplate"
+
+	AUDIT_CREATE_CONTRIBUTION_SCHEDULE = "CreateContributionSchedule"
+	AUDIT_DELETE_CONTRIBUTION_SCHEDULE = "DeleteContributionSchedule"
+)
+
+// calculatedAssetFields are derived by balancing, so they're left out of
+// the audit diffs.
+var calculatedAssetFields = []string{
+	"Id", "ValueVariation", "PercentageFromTotal", "FinalContribution", "DeletedAt", "Binding",
+	"TransactionCost", "SaleEstimate",
+}
+
+type (
+	AuditEntry struct {
+		Id      uuid.UUID
+		GroupId uuid.UUID
+		// TemplateId is set, instead of GroupId, on the entries of a
+		// template.
+		TemplateId *uuid.UUID `json:",omitempty"`
+		AssetId    uuid.UUID
+		Actor      string
+		// Principal is the authenticated caller, which the actor, named by
+		// the caller itself, can't be trusted to tell.
+		Principal  string
+		Operation  string
+		OccurredAt time.Time
+		Changes    []*FieldChange
+	}
+	FieldChange struct {
+		Field  string
+		Before interface{}
+		After  interface{}
+	}
+)
+
+// RecordAudit queues an audit entry to be stored along with the group.
+// A zero assetId means the change is on the group itself. The actor and
+// the principal are filled in when the entry is saved.
+func (ag *AssetsGroup) RecordAudit(operation string, assetId uuid.UUID, changes []*FieldChange) {
+	if len(changes) == 0 {
+		return
+	}
+	ag.audit = append(ag.audit, &AuditEntry{
+		Id:         uuid.New(),
+		GroupId:    ag.Id,
+		AssetId:    assetId,
+		Operation:  operation,
+		OccurredAt: time.Now(),
+		Changes:    changes,
+	})
+}
+
+// PullAudit hands over the recorded audit entries and clears them.
+func (ag *AssetsGroup) PullAudit() []*AuditEntry {
+	audit := ag.audit
+	ag.audit = nil
+	return audit
+}
+
+// DiffAssets lists the fields that differ between two asset states. A
+// nil before or after stands for an asset being added or removed.
+func DiffAssets(before, after *Asset) []*FieldChange {
+	return diffFields(before, after, calculatedAssetFields)
+}
+
+func DiffTemplates(before, after *PortfolioTemplate) []*FieldChange {
+	return diffFields(before, after, []string{"Id", "CreatedAt", "UpdatedAt"})
+}
+
+func DiffAssetsGroups(before, after *AssetsGroup) []*FieldChange {
+	return diffFields(before, after, []string{"Id", "Assets", "ContributionSchedules", "DeletedAssets", "UnallocatedContribution",
+		"Optimization", "EstimatedRealizedGain", "Version"})
+}
+
+func diffFields[T any](before, after *T, skip []string) []*FieldChange {
+	changes := []*FieldChange{}
+	t := reflect.TypeOf((*T)(nil)).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if !f.IsExported() || slices.Contains(skip, f.Name) {
+			continue
+		}
+		var b, a interface{}
+		if before != nil {
+			b = reflect.ValueOf(before).Elem().Field(i).Interface()
+		}
+		if after != nil {
+			a = reflect.ValueOf(after).Elem().Field(i).Interface()
+		}
+		if !reflect.DeepEqual(b, a) {
+			changes = append(changes, &FieldChange{
+				Field:  f.Name,
+				Before: b,
+				After:  a,
+			})
+		}
+	}
+
+	return changes
+}
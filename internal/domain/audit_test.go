@@ -0,0 +1,38 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Should_DiffAssets(t *testing.T) {
+	assert := assert.New(t)
+	a := NewAsset("test", 50, 100, 100, 100, 0, true)
+	before := *a
+	a.Score = 40
+	a.Include = false
+	a.FinalContribution = 12
+
+	changes := DiffAssets(&before, a)
+
+	if !assert.Len(changes, 2) ||
+		!assert.Equal(&FieldChange{Field: "Score", Before: float32(50), After: float32(40)}, changes[0]) ||
+		!assert.Equal(&FieldChange{Field: "Include", Before: true, After: false}, changes[1]) {
+		t.FailNow()
+	}
+}
+
+func Test_Should_DiffRemovedAsset(t *testing.T) {
+	assert := assert.New(t)
+	a := NewAsset("test", 50, 100, 100, 100, 0, true)
+
+	changes := DiffAssets(a, nil)
+
+	if !assert.NotEmpty(changes) ||
+		!assert.Equal("Label", changes[0].Field) ||
+		!assert.Equal("test", changes[0].Before) ||
+		!assert.Nil(changes[0].After) {
+		t.FailNow()
+	}
+}
@@ -0,0 +1,199 @@
+package domain
+
+import (
+	"fmt"
+	"math"
+)
+
+const (
+	CONSTRAINT_MIN_WEIGHT = "MIN_WEIGHT"
+	CONSTRAINT_MAX_WEIGHT = "MAX_WEIGHT"
+	CONSTRAINT_NEVER_SELL = "NEVER_SELL"
+	CONSTRAINT_NEVER_BUY  = "NEVER_BUY"
+	CONSTRAINT_MIN_TRADE  = "MIN_TRADE"
+	// CONSTRAINT_AVOID_GAINS holds back the part of the sale of an asset
+	// that would realize gains, in tax aware groups.
+	CONSTRAINT_AVOID_GAINS = "AVOID_GAINS"
+)
+
+type (
+	// AssetConstraints are hard limits the balancer keeps to whatever the
+	// score of the asset asks for.
+	AssetConstraints struct {
+		// MinWeight and MaxWeight bound the share of the group, in
+		// percent, the asset ends up with after the contribution.
+		MinWeight *float32 `json:",omitempty"`
+		MaxWeight *float32 `json:",omitempty"`
+		// MinTrade is the smallest amount worth buying or selling.
+		MinTrade  float64
+		NeverSell bool
+		NeverBuy  bool
+	}
+	// ConstraintBinding explains why an asset didn't get the contribution
+	// its score asked for.
+	ConstraintBinding struct {
+		Constraint         string
+		TargetContribution float64
+		Explanation        string
+	}
+)
+
+func NewAssetConstraints(
+	minWeight, maxWeight *float32, minTrade float64, neverSell, neverBuy bool) (*AssetConstraints, error) {
+	for _, w := range []*float32{minWeight, maxWeight} {
+		if w != nil && (*w < 0 || *w > 100) {
+			return nil, ErrInvalidConstraints
+		}
+	}
+	if minWeight != nil && maxWeight != nil && *minWeight > *maxWeight {
+		return nil, ErrInvalidConstraints
+	}
+	if minTrade < 0 {
+		return nil, ErrInvalidConstraints
+	}
+
+	return &AssetConstraints{
+		MinWeight: minWeight,
+		MaxWeight: maxWeight,
+		MinTrade:  minTrade,
+		NeverSell: neverSell,
+		NeverBuy:  neverBuy,
+	}, nil
+}
+
+// DistributeContribution works out the contribution of each included
+// asset from its score, then holds the assets whose constraints forbid it
+// to the closest they allow. What they couldn't take, or had to take on
+// top, is spread over the other assets by score, until no constraint is
+// left broken. Whatever no asset can take is left unallocated. In tax
+// aware groups, assets are only sold as far as no gain is realized.
+func (ag *AssetsGroup) DistributeContribution() {
+	currentTotal := ag.CurrentTotal()
+	total := currentTotal + ag.ContributionTotal
+	free := []*Asset{}
+	for _, a := range ag.Assets {
+		a.Binding = nil
+		if a.Include {
+			a.FinalContribution = a.CalculateFinalContribution(ag.ContributionTotal, currentTotal)
+			free = append(free, a)
+		}
+	}
+	targets := map[*Asset]float64{}
+	for _, a := range free {
+		targets[a] = a.FinalContribution
+	}
+	ag.spread(free, total, targets, func(a *Asset) float64 {
+		return float64(a.Score)
+	})
+
+	allocated := 0.
+	for _, a := range ag.Assets {
+		if a.Include {
+			allocated += a.FinalContribution
+		}
+	}
+	ag.UnallocatedContribution = ag.ContributionTotal - allocated
+	if math.Abs(ag.UnallocatedContribution) < 0.005 {
+		ag.UnallocatedContribution = 0
+	}
+}
+
+// spread holds the contribution of each free asset to its constraints
+// and spreads what they couldn't take, or had to take on top, over the
+// others by weight, until no constraint is left broken. The bindings
+// tell the targets the assets were held back from.
+func (ag *AssetsGroup) spread(free []*Asset, total float64,
+	targets map[*Asset]float64, weight func(a *Asset) float64) {
+	for len(free) > 0 {
+		leftover := 0.
+		rest := []*Asset{}
+		for _, a := range free {
+			contribution, constraint := ag.constrain(a, a.FinalContribution, total)
+			if constraint == "" {
+				rest = append(rest, a)
+				continue
+			}
+			leftover += a.FinalContribution - contribution
+			a.FinalContribution = contribution
+			a.Binding = newConstraintBinding(a, constraint, targets[a])
+		}
+		if len(rest) == len(free) {
+			break
+		}
+		free = rest
+
+		weights := 0.
+		for _, a := range free {
+			weights += weight(a)
+		}
+		if weights == 0 {
+			break
+		}
+		for _, a := range free {
+			a.FinalContribution += leftover * weight(a) / weights
+		}
+	}
+}
+
+// constrain holds the contribution of the asset within its constraints,
+// given the total of the group after the contribution, and in tax aware
+// groups to the sale that realizes no gain. It names the last constraint
+// that changed it, if any.
+func (ag *AssetsGroup) constrain(a *Asset, contribution, total float64) (float64, string) {
+	constraint := ""
+	if ag.TaxAware && contribution < 0 {
+		if sale := a.gainFreeSale(-contribution, ag.LotSelection); sale < -contribution {
+			contribution, constraint = -sale, CONSTRAINT_AVOID_GAINS
+		}
+	}
+	k := a.Constraints
+	if k == nil {
+		return contribution, constraint
+	}
+	if k.MinWeight != nil {
+		if floor := total * float64(*k.MinWeight) / 100; a.CurrentValue+contribution < floor {
+			contribution, constraint = floor-a.CurrentValue, CONSTRAINT_MIN_WEIGHT
+		}
+	}
+	if k.MaxWeight != nil {
+		if ceiling := total * float64(*k.MaxWeight) / 100; a.CurrentValue+contribution > ceiling {
+			contribution, constraint = ceiling-a.CurrentValue, CONSTRAINT_MAX_WEIGHT
+		}
+	}
+	if k.NeverSell && contribution < 0 {
+		contribution, constraint = 0, CONSTRAINT_NEVER_SELL
+	}
+	if k.NeverBuy && contribution > 0 {
+		contribution, constraint = 0, CONSTRAINT_NEVER_BUY
+	}
+	if contribution != 0 && math.Abs(contribution) < k.MinTrade {
+		contribution, constraint = 0, CONSTRAINT_MIN_TRADE
+	}
+
+	return contribution, constraint
+}
+
+func newConstraintBinding(a *Asset, constraint string, target float64) *ConstraintBinding {
+	reason := ""
+	switch constraint {
+	case CONSTRAINT_MIN_WEIGHT:
+		reason = fmt.Sprintf("raised to the minimum weight of %g%%", *a.Constraints.MinWeight)
+	case CONSTRAINT_MAX_WEIGHT:
+		reason = fmt.Sprintf("capped at the maximum weight of %g%%", *a.Constraints.MaxWeight)
+	case CONSTRAINT_NEVER_SELL:
+		reason = "the asset is never sold"
+	case CONSTRAINT_NEVER_BUY:
+		reason = "the asset is never bought"
+	case CONSTRAINT_MIN_TRADE:
+		reason = fmt.Sprintf("below the minimum trade of %.2f", a.Constraints.MinTrade)
+	case CONSTRAINT_AVOID_GAINS:
+		reason = fmt.Sprintf("sold only as far as it realizes none of its unrealized gain of %.2f", a.UnrealizedGain())
+	}
+
+	return &ConstraintBinding{
+		Constraint:         constraint,
+		TargetContribution: target,
+		Explanation: fmt.Sprintf("%s: the score asked for %.2f, got %.2f",
+			reason, target, a.FinalContribution),
+	}
+}
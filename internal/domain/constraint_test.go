@@ -0,0 +1,80 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func weight(w float32) *float32 {
+	return &w
+}
+
+func Test_Should_DistributeByScoreWithoutConstraints(t *testing.T) {
+	assert := assert.New(t)
+	group := NewAssetGroup("test", []*Asset{
+		NewAsset("RF", 50, 0, 100, 0, 0, true),
+		NewAsset("RV", 50, 0, 300, 0, 0, true),
+	}, 200)
+
+	group.DistributeContribution()
+
+	assert.EqualValues(200, group.Assets[0].FinalContribution)
+	assert.EqualValues(0, group.Assets[1].FinalContribution)
+	assert.Nil(group.Assets[0].Binding)
+	assert.Zero(group.UnallocatedContribution)
+}
+
+func Test_Should_SpreadWhatConstrainedAssetsCantTake(t *testing.T) {
+	assert := assert.New(t)
+	capped := NewAsset("Stocks", 50, 0, 0, 0, 0, true)
+	capped.Constraints, _ = NewAssetConstraints(nil, weight(30), 0, false, false)
+	bonds := NewAsset("Bonds", 25, 0, 0, 0, 0, true)
+	cash := NewAsset("Cash", 25, 0, 0, 0, 0, true)
+	group := NewAssetGroup("test", []*Asset{capped, bonds, cash}, 1000)
+
+	group.DistributeContribution()
+
+	assert.InDelta(300, capped.FinalContribution, 0.001)
+	assert.InDelta(350, bonds.FinalContribution, 0.001)
+	assert.InDelta(350, cash.FinalContribution, 0.001)
+	if assert.NotNil(capped.Binding) {
+		assert.Equal(CONSTRAINT_MAX_WEIGHT, capped.Binding.Constraint)
+		assert.EqualValues(500, capped.Binding.TargetContribution)
+		assert.Equal("capped at the maximum weight of 30%: the score asked for 500.00, got 300.00",
+			capped.Binding.Explanation)
+	}
+	assert.Zero(group.UnallocatedContribution)
+}
+
+func Test_Should_NeitherSellNorBuyNorTradeTooLittle(t *testing.T) {
+	assert := assert.New(t)
+	held := NewAsset("Held", 50, 0, 1000, 0, 0, true)
+	held.Constraints, _ = NewAssetConstraints(nil, nil, 0, true, false)
+	frozen := NewAsset("Frozen", 25, 0, 0, 0, 0, true)
+	frozen.Constraints, _ = NewAssetConstraints(nil, nil, 0, false, true)
+	small := NewAsset("Small", 25, 0, 0, 0, 0, true)
+	small.Constraints, _ = NewAssetConstraints(nil, nil, 1000, false, false)
+	group := NewAssetGroup("test", []*Asset{held, frozen, small}, 100)
+
+	group.DistributeContribution()
+
+	assert.Zero(held.FinalContribution)
+	assert.Zero(frozen.FinalContribution)
+	assert.Zero(small.FinalContribution)
+	assert.Equal(CONSTRAINT_NEVER_SELL, held.Binding.Constraint)
+	assert.Equal(CONSTRAINT_NEVER_BUY, frozen.Binding.Constraint)
+	assert.Equal(CONSTRAINT_MIN_TRADE, small.Binding.Constraint)
+	assert.EqualValues(100, group.UnallocatedContribution)
+}
+
+func Test_Should_RejectInvalidConstraints(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := NewAssetConstraints(weight(60), weight(40), 0, false, false)
+	assert.EqualError(err, INVALID_CONSTRAINTS)
+	_, err = NewAssetConstraints(nil, weight(120), 0, false, false)
+	assert.EqualError(err, INVALID_CONSTRAINTS)
+	_, err = NewAssetConstraints(nil, nil, -1, false, false)
+	assert.EqualError(err, INVALID_CONSTRAINTS)
+}
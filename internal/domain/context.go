@@ -0,0 +1,58 @@
+package domain
+
+import "context"
+
+const (
+	SYSTEM_ACTOR        = "system"
+	ANONYMOUS_PRINCIPAL = "anonymous"
+)
+
+// contextKey keeps the values put on a context here from colliding with
+// the keys of other packages.
+type contextKey int
+
+const (
+	actorContextKey contextKey = iota
+	requestIdContextKey
+	principalContextKey
+)
+
+// ActorFromContext returns who is acting on the request, or SYSTEM_ACTOR
+// for work started by the service itself. The actor is named by the
+// caller and isn't authenticated, so it labels the audit trail and logs
+// but must not decide quotas or ownership.
+func ActorFromContext(ctx context.Context) string {
+	if actor, ok := ctx.Value(actorContextKey).(string); ok && actor != "" {
+		return actor
+	}
+	return SYSTEM_ACTOR
+}
+
+func WithActor(ctx context.Context, actor string) context.Context {
+	return context.WithValue(ctx, actorContextKey, actor)
+}
+
+// PrincipalFromContext returns who the request was authenticated as by
+// its API key, or ANONYMOUS_PRINCIPAL when it has none. Quotas and
+// ownership go by the principal.
+func PrincipalFromContext(ctx context.Context) string {
+	if principal, ok := ctx.Value(principalContextKey).(string); ok && principal != "" {
+		return principal
+	}
+	return ANONYMOUS_PRINCIPAL
+}
+
+func WithPrincipal(ctx context.Context, principal string) context.Context {
+	return context.WithValue(ctx, principalContextKey, principal)
+}
+
+// RequestIdFromContext returns the id of the request being served, or ""
+// for work started by the service itself.
+func RequestIdFromContext(ctx context.Context) string {
+	id, _ := ctx.Value(requestIdContextKey).(string)
+	return id
+}
+
+func WithRequestId(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIdContextKey, id)
+}
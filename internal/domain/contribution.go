@@ -0,0 +1,190 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	REBALANCE_PLAN_PENDING  = "PENDING"
+	REBALANCE_PLAN_APPROVED = "APPROVED"
+	REBALANCE_PLAN_REJECTED = "REJECTED"
+)
+
+type (
+	ContributionSchedule struct {
+		Id        uuid.UUID
+		Cron      string
+		Amount    float64
+		NextRunAt time.Time
+	}
+	RebalancePlan struct {
+		Id           uuid.UUID
+		GroupId      uuid.UUID
+		ScheduleId   uuid.UUID
+		Contribution float64
+		Items        []*RebalancePlanItem
+		Status       string
+		CreatedAt    time.Time
+		ReviewedAt   time.Time
+	}
+	RebalancePlanItem struct {
+		AssetId uuid.UUID
+		Label   string
+		Amount  float64
+		// Binding explains why the amount falls short of what the asset
+		// lacks, when a constraint held it back.
+		Binding *ConstraintBinding `json:",omitempty"`
+	}
+)
+
+func NewContributionSchedule(cron string, amount float64, now time.Time) (*ContributionSchedule, error) {
+	if amount <= 0 {
+		return nil, ErrInvalidContributionAmount
+	}
+	expr, err := ParseCronExpression(cron)
+	if err != nil {
+		return nil, err
+	}
+	next := expr.Next(now)
+	if next.IsZero() {
+		return nil, ErrInvalidCronExpression
+	}
+
+	return &ContributionSchedule{
+		Id:        uuid.New(),
+		Cron:      cron,
+		Amount:    amount,
+		NextRunAt: next,
+	}, nil
+}
+
+func (cs *ContributionSchedule) IsDue(now time.Time) bool {
+	return !cs.NextRunAt.After(now)
+}
+
+// Advance moves the schedule to its first occurrence after now, skipping
+// any occurrence missed while the scheduler wasn't running.
+func (cs *ContributionSchedule) Advance(now time.Time) {
+	expr, err := ParseCronExpression(cs.Cron)
+	if err != nil {
+		cs.NextRunAt = time.Time{}
+		return
+	}
+	cs.NextRunAt = expr.Next(now)
+}
+
+// NewRebalancePlan splits the contribution among the included assets
+// that are below their target, proportionally to what each one lacks
+// within its constraints. The assets a constraint held back are planned
+// along with the binding, even for nothing.
+// Nothing is ever sold by a plan; optimized groups plan whole lots.
+func NewRebalancePlan(group *AssetsGroup, scheduleId uuid.UUID, contribution float64, now time.Time) *RebalancePlan {
+	work := group.Clone()
+	work.ContributionTotal = contribution
+	if work.RebalanceMode == REBALANCE_MODE_OPTIMIZED {
+		for _, a := range work.Assets {
+			k := AssetConstraints{}
+			if a.Constraints != nil {
+				k = *a.Constraints
+			}
+			k.NeverSell = true
+			a.Constraints = &k
+		}
+	}
+	work.Rebalance()
+	needed := map[uuid.UUID]float64{}
+	sum := 0.
+	for _, a := range work.Assets {
+		if !a.Include {
+			continue
+		}
+		if n := a.FinalContribution; n > 0 {
+			needed[a.Id] = n
+			sum += n
+		}
+	}
+
+	// sales elsewhere don't fund a plan, so when the buys ask for more
+	// than the contribution they're scaled down to it, then held to the
+	// constraints again, what one can't take going to the others by need
+	if sum > contribution {
+		free := []*Asset{}
+		targets := map[*Asset]float64{}
+		for _, a := range work.Assets {
+			n, ok := needed[a.Id]
+			if !ok {
+				continue
+			}
+			a.FinalContribution = n * contribution / sum
+			k := AssetConstraints{}
+			if a.Constraints != nil {
+				k = *a.Constraints
+			}
+			k.NeverSell = true
+			a.Constraints = &k
+			targets[a] = n
+			free = append(free, a)
+		}
+		work.spread(free, work.CurrentTotal()+contribution, targets, func(a *Asset) float64 {
+			return needed[a.Id]
+		})
+	}
+	items := []*RebalancePlanItem{}
+	for _, a := range work.Assets {
+		if _, ok := needed[a.Id]; !ok || (a.FinalContribution <= 0 && a.Binding == nil) {
+			continue
+		}
+		var binding *ConstraintBinding
+		if a.Binding != nil {
+			binding = newConstraintBinding(a, a.Binding.Constraint, a.Binding.TargetContribution)
+		}
+		items = append(items, &RebalancePlanItem{
+			AssetId: a.Id,
+			Label:   a.Label,
+			Amount:  a.FinalContribution,
+			Binding: binding,
+		})
+	}
+
+	return &RebalancePlan{
+		Id:           uuid.New(),
+		GroupId:      group.Id,
+		ScheduleId:   scheduleId,
+		Contribution: contribution,
+		Items:        items,
+		Status:       REBALANCE_PLAN_PENDING,
+		CreatedAt:    now,
+	}
+}
+
+// Apply adds each planned amount to the current value of its asset, and
+// the shares it buys at the current price to its quantity, so the next
+// revaluation prices them too.
+func (rp *RebalancePlan) Apply(group *AssetsGroup) {
+	for _, item := range rp.Items {
+		for _, a := range group.Assets {
+			if a.Id != item.AssetId {
+				continue
+			}
+			if price := a.UnitPrice(); price > 0 {
+				a.Quantity += item.Amount / price
+			}
+			a.CurrentValue += item.Amount
+		}
+	}
+}
+
+func (rp *RebalancePlan) Review(approved bool, now time.Time) error {
+	if rp.Status != REBALANCE_PLAN_PENDING {
+		return ErrRebalancePlanNotPending
+	}
+	rp.Status = REBALANCE_PLAN_REJECTED
+	if approved {
+		rp.Status = REBALANCE_PLAN_APPROVED
+	}
+	rp.ReviewedAt = now
+
+	return nil
+}
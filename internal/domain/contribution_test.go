@@ -0,0 +1,89 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Should_CreateRebalancePlanWithoutSelling(t *testing.T) {
+	assert := assert.New(t)
+	under := NewAsset("under", 50, 100, 100, 400, 0, true)
+	over := NewAsset("over", 50, 100, 300, 400, 0, true)
+	excluded := NewAsset("excluded", 0, 100, 100, 400, 0, false)
+	group := NewAssetGroup("test", []*Asset{under, over, excluded}, 0)
+	schedule, err := NewContributionSchedule("0 9 1 * *", 200, time.Now())
+	if !assert.Nil(err) {
+		t.FailNow()
+	}
+
+	plan := NewRebalancePlan(group, schedule.Id, schedule.Amount, time.Now())
+
+	if !assert.Equal(REBALANCE_PLAN_PENDING, plan.Status) ||
+		!assert.Len(plan.Items, 1) ||
+		!assert.Equal(under.Id, plan.Items[0].AssetId) ||
+		!assert.EqualValues(200, plan.Items[0].Amount) {
+		t.FailNow()
+	}
+
+	plan.Apply(group)
+	assert.EqualValues(300, under.CurrentValue)
+}
+
+func Test_Should_HoldScaledDownPlansToTheConstraints(t *testing.T) {
+	assert := assert.New(t)
+	small := NewAsset("small", 40, 0, 0, 1000, 0, true)
+	small.Constraints, _ = NewAssetConstraints(nil, nil, 100, false, false)
+	other := NewAsset("other", 40, 0, 0, 1000, 0, true)
+	over := NewAsset("over", 20, 0, 1000, 1000, 0, true)
+	group := NewAssetGroup("test", []*Asset{small, other, over}, 0)
+
+	plan := NewRebalancePlan(group, group.Id, 100, time.Now())
+
+	if !assert.Len(plan.Items, 2) {
+		t.FailNow()
+	}
+	assert.Equal(small.Id, plan.Items[0].AssetId)
+	assert.Zero(plan.Items[0].Amount)
+	if assert.NotNil(plan.Items[0].Binding) {
+		assert.Equal(CONSTRAINT_MIN_TRADE, plan.Items[0].Binding.Constraint)
+		assert.EqualValues(440, plan.Items[0].Binding.TargetContribution)
+	}
+	assert.InDelta(100, plan.Items[1].Amount, 1e-6)
+	assert.Nil(plan.Items[1].Binding)
+	assert.False(small.Constraints.NeverSell)
+}
+
+func Test_Should_BuyTheSharesOfTheAppliedPlan(t *testing.T) {
+	assert := assert.New(t)
+	priced := NewAsset("priced", 50, 100, 100, 200, 0, true)
+	priced.Quantity = 10
+	manual := NewAsset("manual", 50, 100, 100, 200, 0, true)
+	group := NewAssetGroup("test", []*Asset{priced, manual}, 0)
+	plan := NewRebalancePlan(group, group.Id, 200, time.Now())
+
+	plan.Apply(group)
+	assert.EqualValues(20, priced.Quantity)
+	assert.EqualValues(200, priced.CurrentValue)
+	assert.Zero(manual.Quantity)
+	assert.EqualValues(200, manual.CurrentValue)
+
+	// the next revaluation prices what the plan bought and keeps the rest
+	priced.Revalue(10)
+	manual.Revalue(10)
+	assert.EqualValues(200, priced.CurrentValue)
+	assert.EqualValues(200, manual.CurrentValue)
+}
+
+func Test_Should_Not_ReviewRebalancePlanTwice(t *testing.T) {
+	assert := assert.New(t)
+	group := NewAssetGroup("test", []*Asset{}, 0)
+	plan := NewRebalancePlan(group, group.Id, 100, time.Now())
+
+	if !assert.Nil(plan.Review(true, time.Now())) ||
+		!assert.Equal(REBALANCE_PLAN_APPROVED, plan.Status) {
+		t.FailNow()
+	}
+	assert.EqualError(plan.Review(false, time.Now()), REBALANCE_PLAN_NOT_PENDING)
+}
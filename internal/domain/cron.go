@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+type (
+	// CronExpression is a standard five field cron expression
+	// (minute hour day-of-month month day-of-week) supporting
+	// "*", lists, ranges and steps.
+	CronExpression struct {
+		minutes     []bool
+		hours       []bool
+		daysOfMonth []bool
+		months      []bool
+		daysOfWeek  []bool
+		anyDom      bool
+		anyDow      bool
+	}
+	cronField struct {
+		min, max int
+	}
+)
+
+// cronSearchLimit bounds the search for the next occurrence so
+// impossible expressions such as "0 0 31 2 *" don't loop forever.
+const cronSearchLimit = 5 * 366
+
+var cronFields = []cronField{
+	{0, 59}, {0, 23}, {1, 31}, {1, 12}, {0, 6},
+}
+
+func ParseCronExpression(expr string) (*CronExpression, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(cronFields) {
+		return nil, ErrInvalidCronExpression
+	}
+	sets := make([][]bool, len(parts))
+	for i, p := range parts {
+		set, err := parseCronField(p, cronFields[i])
+		if err != nil {
+			return nil, err
+		}
+		sets[i] = set
+	}
+
+	return &CronExpression{
+		minutes:     sets[0],
+		hours:       sets[1],
+		daysOfMonth: sets[2],
+		months:      sets[3],
+		daysOfWeek:  sets[4],
+		anyDom:      parts[2] == "*",
+		anyDow:      parts[4] == "*",
+	}, nil
+}
+
+// Next returns the first occurrence strictly after the given time,
+// or the zero time when there's none within the search limit.
+func (c *CronExpression) Next(after time.Time) time.Time {
+	t := after.Truncate(time.Minute).Add(time.Minute)
+	for days := 0; days <= cronSearchLimit; days++ {
+		y, m, d := t.Date()
+		if c.matchesDay(t) {
+			for h := t.Hour(); h < 24; h++ {
+				if !c.hours[h] {
+					continue
+				}
+				fromMinute := 0
+				if h == t.Hour() {
+					fromMinute = t.Minute()
+				}
+				for minute := fromMinute; minute < 60; minute++ {
+					if c.minutes[minute] {
+						return time.Date(y, m, d, h, minute, 0, 0, t.Location())
+					}
+				}
+			}
+		}
+		t = time.Date(y, m, d+1, 0, 0, 0, 0, t.Location())
+	}
+
+	return time.Time{}
+}
+
+func (c *CronExpression) matchesDay(t time.Time) bool {
+	if !c.months[int(t.Month())] {
+		return false
+	}
+	dom := c.daysOfMonth[t.Day()]
+	dow := c.daysOfWeek[int(t.Weekday())]
+	// as in cron, a restricted day-of-month and day-of-week match
+	// when either of them does.
+	switch {
+	case c.anyDom && c.anyDow:
+		return true
+	case c.anyDom:
+		return dow
+	case c.anyDow:
+		return dom
+	default:
+		return dom || dow
+	}
+}
+
+func parseCronField(field string, bounds cronField) ([]bool, error) {
+	set := make([]bool, bounds.max+1)
+	for _, item := range strings.Split(field, ",") {
+		rng, step := item, 1
+		if i := strings.Index(item, "/"); i >= 0 {
+			s, err := strconv.Atoi(item[i+1:])
+			if err != nil || s <= 0 {
+				return nil, ErrInvalidCronExpression
+			}
+			rng, step = item[:i], s
+		}
+
+		from, to := bounds.min, bounds.max
+		if rng != "*" {
+			bs := strings.SplitN(rng, "-", 2)
+			var err error
+			if from, err = strconv.Atoi(bs[0]); err != nil {
+				return nil, ErrInvalidCronExpression
+			}
+			to = from
+			if len(bs) == 2 {
+				if to, err = strconv.Atoi(bs[1]); err != nil {
+					return nil, ErrInvalidCronExpression
+				}
+			} else if step > 1 {
+				to = bounds.max
+			}
+		}
+		if from < bounds.min || to > bounds.max || from > to {
+			return nil, ErrInvalidCronExpression
+		}
+		for v := from; v <= to; v += step {
+			set[v] = true
+		}
+	}
+
+	return set, nil
+}
@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Should_GetNextCronOccurrence(t *testing.T) {
+	assert := assert.New(t)
+	from := time.Date(2026, time.January, 15, 10, 30, 0, 0, time.UTC)
+	cases := map[string]time.Time{
+		"0 9 1 * *":      time.Date(2026, time.February, 1, 9, 0, 0, 0, time.UTC),
+		"*/15 * * * *":   time.Date(2026, time.January, 15, 10, 45, 0, 0, time.UTC),
+		"0 8 * * 1":      time.Date(2026, time.January, 19, 8, 0, 0, 0, time.UTC),
+		"0 0 1 1,7 *":    time.Date(2026, time.July, 1, 0, 0, 0, 0, time.UTC),
+		"30 10-12 * * *": time.Date(2026, time.January, 15, 11, 30, 0, 0, time.UTC),
+	}
+	for expr, expected := range cases {
+		c, err := ParseCronExpression(expr)
+		if !assert.Nil(err, expr) ||
+			!assert.Equal(expected, c.Next(from), expr) {
+			t.FailNow()
+		}
+	}
+}
+
+func Test_Should_Not_ParseInvalidCronExpression(t *testing.T) {
+	assert := assert.New(t)
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "*/0 * * * *", "5-1 * * * *"} {
+		_, err := ParseCronExpression(expr)
+		assert.EqualError(err, INVALID_CRON_EXPRESSION, expr)
+	}
+}
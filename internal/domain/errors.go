@@ -1,6 +1,73 @@
 package domain
 
+import "errors"
+
+// The codes the API answers with when the balancer rejects a request.
 const (
-	INVALID_SCORE_SUM      = "INVALID_SCORE_SUM"
-	ASSETS_GROUP_NOT_FOUND = "ASSETS_GROUP_NOT_FOUND"
+	INVALID_SCORE_SUM        = "INVALID_SCORE_SUM"
+	ASSETS_GROUP_NOT_FOUND   = "ASSETS_GROUP_NOT_FOUND"
+	ASSET_NOT_FOUND          = "ASSET_NOT_FOUND"
+	INVALID_BATCH_OPERATION  = "INVALID_BATCH_OPERATION"
+	TOO_MANY_ASSETS          = "TOO_MANY_ASSETS"
+	TOO_MANY_ASSETS_GROUPS   = "TOO_MANY_ASSETS_GROUPS"
+	PRICE_NOT_FOUND          = "PRICE_NOT_FOUND"
+	PRICE_PROVIDER_FAILED    = "PRICE_PROVIDER_FAILED"
+	INVALID_PRICE            = "INVALID_PRICE"
+	TEMPLATE_NOT_FOUND       = "TEMPLATE_NOT_FOUND"
+	TEMPLATE_NOT_OWNED       = "TEMPLATE_NOT_OWNED"
+	INVALID_GLIDE_PATH       = "INVALID_GLIDE_PATH"
+	SCORE_FOLLOWS_GLIDE_PATH = "SCORE_FOLLOWS_GLIDE_PATH"
+	INVALID_PREVIEW_RANGE    = "INVALID_PREVIEW_RANGE"
+	INVALID_CONSTRAINTS      = "INVALID_CONSTRAINTS"
+	INVALID_TRADING_COSTS    = "INVALID_TRADING_COSTS"
+	INVALID_TAX_LOT          = "INVALID_TAX_LOT"
+	TAX_LOT_NOT_FOUND        = "TAX_LOT_NOT_FOUND"
+	ASSETS_GROUP_CONFLICT    = "ASSETS_GROUP_CONFLICT"
+
+	INVALID_CRON_EXPRESSION     = "INVALID_CRON_EXPRESSION"
+	INVALID_CONTRIBUTION_AMOUNT = "INVALID_CONTRIBUTION_AMOUNT"
+	SCHEDULE_NOT_FOUND          = "SCHEDULE_NOT_FOUND"
+	REBALANCE_PLAN_NOT_FOUND    = "REBALANCE_PLAN_NOT_FOUND"
+	REBALANCE_PLAN_NOT_PENDING  = "REBALANCE_PLAN_NOT_PENDING"
+
+	INVALID_NOTIFICATION_CHANNEL = "INVALID_NOTIFICATION_CHANNEL"
+	INVALID_NOTIFICATION_TARGET  = "INVALID_NOTIFICATION_TARGET"
+	INVALID_NOTIFICATION_EVENT   = "INVALID_NOTIFICATION_EVENT"
+	INVALID_DRIFT_THRESHOLD      = "INVALID_DRIFT_THRESHOLD"
+	SUBSCRIPTION_NOT_FOUND       = "SUBSCRIPTION_NOT_FOUND"
+)
+
+// The errors the balancer rejects requests with, for errors.Is.
+var (
+	ErrInvalidScoreSum       = errors.New(INVALID_SCORE_SUM)
+	ErrAssetsGroupNotFound   = errors.New(ASSETS_GROUP_NOT_FOUND)
+	ErrAssetNotFound         = errors.New(ASSET_NOT_FOUND)
+	ErrInvalidBatchOperation = errors.New(INVALID_BATCH_OPERATION)
+	ErrTooManyAssets         = errors.New(TOO_MANY_ASSETS)
+	ErrTooManyAssetsGroups   = errors.New(TOO_MANY_ASSETS_GROUPS)
+	ErrPriceNotFound         = errors.New(PRICE_NOT_FOUND)
+	ErrPriceProviderFailed   = errors.New(PRICE_PROVIDER_FAILED)
+	ErrInvalidPrice          = errors.New(INVALID_PRICE)
+	ErrTemplateNotFound      = errors.New(TEMPLATE_NOT_FOUND)
+	ErrTemplateNotOwned      = errors.New(TEMPLATE_NOT_OWNED)
+	ErrInvalidGlidePath      = errors.New(INVALID_GLIDE_PATH)
+	ErrScoreFollowsGlidePath = errors.New(SCORE_FOLLOWS_GLIDE_PATH)
+	ErrInvalidPreviewRange   = errors.New(INVALID_PREVIEW_RANGE)
+	ErrInvalidConstraints    = errors.New(INVALID_CONSTRAINTS)
+	ErrInvalidTradingCosts   = errors.New(INVALID_TRADING_COSTS)
+	ErrInvalidTaxLot         = errors.New(INVALID_TAX_LOT)
+	ErrTaxLotNotFound        = errors.New(TAX_LOT_NOT_FOUND)
+	ErrAssetsGroupConflict   = errors.New(ASSETS_GROUP_CONFLICT)
+
+	ErrInvalidCronExpression     = errors.New(INVALID_CRON_EXPRESSION)
+	ErrInvalidContributionAmount = errors.New(INVALID_CONTRIBUTION_AMOUNT)
+	ErrScheduleNotFound          = errors.New(SCHEDULE_NOT_FOUND)
+	ErrRebalancePlanNotFound     = errors.New(REBALANCE_PLAN_NOT_FOUND)
+	ErrRebalancePlanNotPending   = errors.New(REBALANCE_PLAN_NOT_PENDING)
+
+	ErrInvalidNotificationChannel = errors.New(INVALID_NOTIFICATION_CHANNEL)
+	ErrInvalidNotificationTarget  = errors.New(INVALID_NOTIFICATION_TARGET)
+	ErrInvalidNotificationEvent   = errors.New(INVALID_NOTIFICATION_EVENT)
+	ErrInvalidDriftThreshold      = errors.New(INVALID_DRIFT_THRESHOLD)
+	ErrSubscriptionNotFound       = errors.New(SUBSCRIPTION_NOT_FOUND)
 )
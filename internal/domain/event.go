@@ -0,0 +1,169 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	EVENT_ASSETS_GROUP_CREATED  = "AssetsGroupCreated"
+	EVENT_ASSETS_GROUP_RENAMED  = "AssetsGroupRenamed"
+	EVENT_ASSETS_GROUP_DELETED  = "AssetsGroupDeleted"
+	EVENT_ASSETS_GROUP_RESTORED = "AssetsGroupRestored"
+	EVENT_CONTRIBUTION_CHANGED  = "ContributionChanged"
+	EVENT_ASSET_ADDED           = "AssetAdded"
+	EVENT_ASSET_UPDATED         = "AssetUpdated"
+	EVENT_ASSET_VALUE_CHANGED   = "AssetValueChanged"
+	EVENT_ASSET_REMOVED         = "AssetRemoved"
+	EVENT_ASSET_RESTORED        = "AssetRestored"
+	EVENT_ASSETS_GROUP_PURGED   = "AssetsGroupPurged"
+	EVENT_ASSET_PURGED          = "AssetPurged"
+)
+
+type (
+	// Event is implemented by every typed domain event.
+	Event interface {
+		EventType() string
+	}
+	// DomainEvent is the envelope an event travels in, through the outbox
+	// and to the sinks. Payload holds the JSON of the typed event.
+	DomainEvent struct {
+		Id           uuid.UUID
+		Type         string
+		AggregateId  uuid.UUID
+		OccurredAt   time.Time
+		Payload      json.RawMessage
+		DispatchedAt *time.Time
+	}
+
+	AssetsGroupCreated struct {
+		Label             string
+		ContributionTotal float64
+		Assets            int
+	}
+	AssetsGroupRenamed struct {
+		PreviousLabel string
+		Label         string
+	}
+	AssetsGroupDeleted struct {
+		Label string
+	}
+	AssetsGroupRestored struct {
+		Label string
+	}
+	ContributionChanged struct {
+		PreviousContributionTotal float64
+		ContributionTotal         float64
+	}
+	AssetAdded struct {
+		AssetId      uuid.UUID
+		Label        string
+		Score        float32
+		CurrentValue float64
+	}
+	AssetUpdated struct {
+		AssetId uuid.UUID
+		Label   string
+		Ticker  string
+		Score   float32
+		Include bool
+	}
+	AssetValueChanged struct {
+		AssetId       uuid.UUID
+		PreviousValue float64
+		CurrentValue  float64
+	}
+	AssetRemoved struct {
+		AssetId uuid.UUID
+		Label   string
+	}
+	AssetRestored struct {
+		AssetId uuid.UUID
+		Label   string
+	}
+	AssetsGroupPurged struct {
+		Label string
+	}
+	AssetPurged struct {
+		AssetId uuid.UUID
+		Label   string
+	}
+)
+
+func (AssetsGroupCreated) EventType() string  { return EVENT_ASSETS_GROUP_CREATED }
+func (AssetsGroupRenamed) EventType() string  { return EVENT_ASSETS_GROUP_RENAMED }
+func (AssetsGroupDeleted) EventType() string  { return EVENT_ASSETS_GROUP_DELETED }
+func (ContributionChanged) EventType() string { return EVENT_CONTRIBUTION_CHANGED }
+func (AssetAdded) EventType() string          { return EVENT_ASSET_ADDED }
+func (AssetUpdated) EventType() string        { return EVENT_ASSET_UPDATED }
+func (AssetValueChanged) EventType() string   { return EVENT_ASSET_VALUE_CHANGED }
+func (AssetRemoved) EventType() string        { return EVENT_ASSET_REMOVED }
+func (AssetsGroupRestored) EventType() string { return EVENT_ASSETS_GROUP_RESTORED }
+func (AssetRestored) EventType() string       { return EVENT_ASSET_RESTORED }
+func (AssetsGroupPurged) EventType() string   { return EVENT_ASSETS_GROUP_PURGED }
+func (AssetPurged) EventType() string         { return EVENT_ASSET_PURGED }
+
+func NewDomainEvent(aggregateId uuid.UUID, e Event, now time.Time) (*DomainEvent, error) {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DomainEvent{
+		Id:          uuid.New(),
+		Type:        e.EventType(),
+		AggregateId: aggregateId,
+		OccurredAt:  now,
+		Payload:     payload,
+	}, nil
+}
+
+// Record queues an event to be stored along with the group. An event
+// that can't be recorded fails the next PullEvents, so the group isn't
+// saved without it.
+func (ag *AssetsGroup) Record(e Event) {
+	de, err := NewDomainEvent(ag.Id, e, time.Now())
+	if err != nil {
+		if ag.eventsErr == nil {
+			ag.eventsErr = err
+		}
+		return
+	}
+	ag.events = append(ag.events, de)
+}
+
+// PullEvents hands over the recorded events and clears them, or the
+// error an event failed to be recorded with.
+func (ag *AssetsGroup) PullEvents() ([]*DomainEvent, error) {
+	events, err := ag.events, ag.eventsErr
+	ag.events, ag.eventsErr = nil, nil
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
+}
+
+// RecordAssetChanges records the events and the audit entry for what
+// changed on an asset between the before snapshot and its current state.
+func (ag *AssetsGroup) RecordAssetChanges(operation string, before Asset, after *Asset) {
+	ag.RecordAudit(operation, after.Id, DiffAssets(&before, after))
+	if before.Label != after.Label || before.Ticker != after.Ticker ||
+		before.Score != after.Score || before.Include != after.Include {
+		ag.Record(AssetUpdated{
+			AssetId: after.Id,
+			Label:   after.Label,
+			Ticker:  after.Ticker,
+			Score:   after.Score,
+			Include: after.Include,
+		})
+	}
+	if before.CurrentValue != after.CurrentValue {
+		ag.Record(AssetValueChanged{
+			AssetId:       after.Id,
+			PreviousValue: before.CurrentValue,
+			CurrentValue:  after.CurrentValue,
+		})
+	}
+}
@@ -0,0 +1,55 @@
+package domain
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Should_RecordAssetChanges(t *testing.T) {
+	assert := assert.New(t)
+	a := NewAsset("test", 50, 100, 100, 100, 0, true)
+	group := NewAssetGroup("test", []*Asset{a}, 0)
+	before := *a
+	a.CurrentValue = 120
+
+	group.RecordAssetChanges(AUDIT_UPDATE_ASSET, before, a)
+	events, err := group.PullEvents()
+	pulled, _ := group.PullEvents()
+
+	if !assert.Nil(err) ||
+		!assert.Len(events, 1) ||
+		!assert.Equal(EVENT_ASSET_VALUE_CHANGED, events[0].Type) ||
+		!assert.Equal(group.Id, events[0].AggregateId) ||
+		!assert.Empty(pulled) {
+		t.FailNow()
+	}
+	payload := &AssetValueChanged{}
+	if !assert.Nil(json.Unmarshal(events[0].Payload, payload)) ||
+		!assert.EqualValues(100, payload.PreviousValue) ||
+		!assert.EqualValues(120, payload.CurrentValue) {
+		t.FailNow()
+	}
+}
+
+type unmarshalableEvent struct {
+	Done chan struct{}
+}
+
+func (unmarshalableEvent) EventType() string { return "Unmarshalable" }
+
+func Test_Should_FailToPullEventsThatCouldNotBeRecorded(t *testing.T) {
+	assert := assert.New(t)
+	group := NewAssetGroup("test", nil, 0)
+
+	group.Record(unmarshalableEvent{})
+	group.Record(AssetsGroupRenamed{Label: "renamed"})
+	events, err := group.PullEvents()
+
+	assert.Nil(events)
+	assert.NotNil(err)
+	events, err = group.PullEvents()
+	assert.Nil(err)
+	assert.Empty(events)
+}
@@ -0,0 +1,200 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	GLIDE_PATH_LINEAR = "LINEAR"
+	GLIDE_PATH_STEP   = "STEP"
+)
+
+type (
+	// GlidePath moves the score of an asset over time. Between two points
+	// the score goes in a straight line from one to the other, or stays at
+	// the first one until the next is reached when stepping. Before the
+	// first point and after the last the score is the one of that point.
+	// Meanwhile the score can't be set by hand.
+	GlidePath struct {
+		Interpolation string
+		Points        []*GlidePathPoint
+	}
+	GlidePathPoint struct {
+		At    time.Time
+		Score float32
+	}
+	// GlidePathPreview is the score each asset of a group aims for at a
+	// given date.
+	GlidePathPreview struct {
+		At      time.Time
+		Targets []*AssetTarget
+	}
+	AssetTarget struct {
+		AssetId uuid.UUID
+		Label   string
+		Score   float32
+	}
+)
+
+// NewGlidePath checks that the points are in chronological order, one
+// per date, and that the interpolation is known. An empty interpolation
+// means LINEAR.
+func NewGlidePath(interpolation string, points []*GlidePathPoint) (*GlidePath, error) {
+	if interpolation == "" {
+		interpolation = GLIDE_PATH_LINEAR
+	}
+	if interpolation != GLIDE_PATH_LINEAR && interpolation != GLIDE_PATH_STEP {
+		return nil, ErrInvalidGlidePath
+	}
+	if len(points) == 0 {
+		return nil, ErrInvalidGlidePath
+	}
+	for i, p := range points {
+		if p.Score < 0 || p.Score > 100 {
+			return nil, ErrInvalidGlidePath
+		}
+		if i > 0 && !p.At.After(points[i-1].At) {
+			return nil, ErrInvalidGlidePath
+		}
+	}
+
+	return &GlidePath{
+		Interpolation: interpolation,
+		Points:        points,
+	}, nil
+}
+
+// ScoreAt tells the score in effect at t.
+func (gp *GlidePath) ScoreAt(t time.Time) float32 {
+	first, last := gp.Points[0], gp.Points[len(gp.Points)-1]
+	if !t.After(first.At) {
+		return first.Score
+	}
+	if !t.Before(last.At) {
+		return last.Score
+	}
+
+	i := 1
+	for gp.Points[i].At.Before(t) {
+		i++
+	}
+	from, to := gp.Points[i-1], gp.Points[i]
+	if !to.At.After(t) {
+		return to.Score
+	}
+	if gp.Interpolation == GLIDE_PATH_STEP {
+		return from.Score
+	}
+	elapsed := float64(t.Sub(from.At)) / float64(to.At.Sub(from.At))
+
+	return from.Score + float32(elapsed*float64(to.Score-from.Score))
+}
+
+// End is the date of the last point, after which the score stays put.
+func (gp *GlidePath) End() time.Time {
+	return gp.Points[len(gp.Points)-1].At
+}
+
+// FollowGlidePaths gives each asset the score in effect at now, as
+// TargetsAt tells it. It tells whether any score changed.
+func (ag *AssetsGroup) FollowGlidePaths(now time.Time) bool {
+	changed := false
+	scores := ag.scoresAt(now)
+	for i, a := range ag.Assets {
+		if scores[i] == a.Score {
+			continue
+		}
+		before := *a
+		a.Score = scores[i]
+		ag.RecordAssetChanges(AUDIT_FOLLOW_GLIDE_PATH, before, a)
+		changed = true
+	}
+
+	return changed
+}
+
+// TargetsAt tells the score of each asset at t, following the glide
+// paths. When the included assets don't add up to 100 that way, those
+// without a glide path share what the glide paths leave, in proportion
+// to their current scores, or the glide paths are scaled to 100 when
+// there is nothing left or no one to share it.
+func (ag *AssetsGroup) TargetsAt(t time.Time) *GlidePathPreview {
+	preview := &GlidePathPreview{
+		At:      t,
+		Targets: []*AssetTarget{},
+	}
+	scores := ag.scoresAt(t)
+	for i, a := range ag.Assets {
+		preview.Targets = append(preview.Targets, &AssetTarget{
+			AssetId: a.Id,
+			Label:   a.Label,
+			Score:   scores[i],
+		})
+	}
+
+	return preview
+}
+
+// scoresAt tells the score of each asset at t, in the order of the
+// assets, rounded to hundredths. Groups none of whose included assets
+// follow a glide path keep their scores.
+func (ag *AssetsGroup) scoresAt(t time.Time) []float32 {
+	scores := make([]float32, len(ag.Assets))
+	following, fixed := 0., 0.
+	for i, a := range ag.Assets {
+		scores[i] = a.Score
+		if a.GlidePath != nil {
+			scores[i] = a.GlidePath.ScoreAt(t)
+		}
+		if !a.Include {
+			continue
+		}
+		if a.GlidePath != nil {
+			following += float64(scores[i])
+		} else {
+			fixed += float64(scores[i])
+		}
+	}
+	if !slices.ContainsFunc(ag.Assets, func(a *Asset) bool {
+		return a.Include && a.GlidePath != nil
+	}) {
+		return scores
+	}
+
+	left := 100 - following
+	for i, a := range ag.Assets {
+		if !a.Include {
+			continue
+		}
+		switch {
+		case a.GlidePath == nil && left >= 0 && fixed > 0:
+			scores[i] = float32(float64(scores[i]) * left / fixed)
+		case a.GlidePath == nil:
+			scores[i] = 0
+		case (left < 0 || fixed == 0) && following > 0:
+			scores[i] = float32(float64(scores[i]) * 100 / following)
+		}
+	}
+	for i := range scores {
+		scores[i] = float32(math.Round(float64(scores[i])*100) / 100)
+	}
+
+	return scores
+}
+
+// GlidePathsEnd is the date after which no glide path of the group
+// changes anymore, or the zero time when none of its assets has one.
+func (ag *AssetsGroup) GlidePathsEnd() time.Time {
+	end := time.Time{}
+	for _, a := range ag.Assets {
+		if a.GlidePath != nil && a.GlidePath.End().After(end) {
+			end = a.GlidePath.End()
+		}
+	}
+
+	return end
+}
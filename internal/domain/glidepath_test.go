@@ -0,0 +1,128 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func date(year int, month time.Month) time.Time {
+	return time.Date(year, month, 1, 0, 0, 0, 0, time.UTC)
+}
+
+func Test_Should_FollowGlidePathsLinearlyOrBySteps(t *testing.T) {
+	assert := assert.New(t)
+	points := []*GlidePathPoint{
+		{At: date(2026, 1), Score: 90},
+		{At: date(2036, 1), Score: 70},
+		{At: date(2050, 1), Score: 50},
+	}
+	linear, err := NewGlidePath("", points)
+	if !assert.Nil(err) {
+		t.FailNow()
+	}
+	step, _ := NewGlidePath(GLIDE_PATH_STEP, points)
+
+	assert.Equal(GLIDE_PATH_LINEAR, linear.Interpolation)
+	assert.EqualValues(90, linear.ScoreAt(date(2020, 1)))
+	assert.InDelta(80, linear.ScoreAt(date(2031, 1)), 0.1)
+	assert.EqualValues(70, linear.ScoreAt(date(2036, 1)))
+	assert.EqualValues(50, linear.ScoreAt(date(2060, 1)))
+	assert.EqualValues(90, step.ScoreAt(date(2035, 12)))
+	assert.EqualValues(70, step.ScoreAt(date(2049, 12)))
+	assert.EqualValues(50, step.ScoreAt(date(2050, 1)))
+}
+
+func Test_Should_RejectInvalidGlidePaths(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := NewGlidePath("CUBIC", []*GlidePathPoint{{At: date(2026, 1), Score: 90}})
+	assert.EqualError(err, INVALID_GLIDE_PATH)
+	_, err = NewGlidePath("", nil)
+	assert.EqualError(err, INVALID_GLIDE_PATH)
+	_, err = NewGlidePath("", []*GlidePathPoint{{At: date(2026, 1), Score: 120}})
+	assert.EqualError(err, INVALID_GLIDE_PATH)
+	_, err = NewGlidePath("", []*GlidePathPoint{
+		{At: date(2030, 1), Score: 90},
+		{At: date(2030, 1), Score: 50},
+	})
+	assert.EqualError(err, INVALID_GLIDE_PATH)
+}
+
+func Test_Should_ApplyTheTargetInEffectAndPreviewLaterOnes(t *testing.T) {
+	assert := assert.New(t)
+	equities := NewAsset("Equities", 90, 0, 0, 0, 0, true)
+	equities.GlidePath, _ = NewGlidePath("", []*GlidePathPoint{
+		{At: date(2026, 1), Score: 90},
+		{At: date(2050, 1), Score: 50},
+	})
+	bonds := NewAsset("Bonds", 10, 0, 0, 0, 0, true)
+	bonds.GlidePath, _ = NewGlidePath("", []*GlidePathPoint{
+		{At: date(2026, 1), Score: 10},
+		{At: date(2050, 1), Score: 50},
+	})
+	group := NewAssetGroup("retirement", []*Asset{equities, bonds}, 0)
+
+	assert.False(group.FollowGlidePaths(date(2025, 6)))
+	assert.True(group.FollowGlidePaths(date(2050, 1)))
+	assert.EqualValues(50, equities.Score)
+	assert.EqualValues(50, bonds.Score)
+	if assert.Len(group.PullAudit(), 2) {
+		events, err := group.PullEvents()
+		assert.Nil(err)
+		assert.Len(events, 2)
+	}
+
+	preview := group.TargetsAt(date(2038, 1))
+	assert.EqualValues(70, preview.Targets[0].Score)
+	assert.EqualValues(30, preview.Targets[1].Score)
+	assert.Equal(date(2050, 1), group.GlidePathsEnd())
+}
+
+func Test_Should_RoundGlidePathScoresAndOnlyRecordRealChanges(t *testing.T) {
+	assert := assert.New(t)
+	equities := NewAsset("Equities", 90, 0, 0, 0, 0, true)
+	equities.GlidePath, _ = NewGlidePath("", []*GlidePathPoint{
+		{At: date(2026, 1), Score: 90},
+		{At: date(2050, 1), Score: 50},
+	})
+	bonds := NewAsset("Bonds", 10, 0, 0, 0, 0, true)
+	group := NewAssetGroup("retirement", []*Asset{equities, bonds}, 0)
+	now := date(2031, 1)
+
+	assert.True(group.FollowGlidePaths(now))
+	assert.Equal(float32(math.Round(float64(equities.Score)*100)/100), equities.Score)
+	assert.InDelta(100, equities.Score+bonds.Score, 0.01)
+	group.PullAudit()
+
+	assert.False(group.FollowGlidePaths(now.Add(time.Second)))
+	assert.Empty(group.PullAudit())
+}
+
+func Test_Should_LetAssetsWithoutGlidePathsShareWhatTheGlidePathsLeave(t *testing.T) {
+	assert := assert.New(t)
+	equities := NewAsset("Equities", 90, 0, 0, 0, 0, true)
+	equities.GlidePath, _ = NewGlidePath(GLIDE_PATH_STEP, []*GlidePathPoint{
+		{At: date(2026, 1), Score: 90},
+		{At: date(2050, 1), Score: 40},
+	})
+	bonds := NewAsset("Bonds", 6, 0, 0, 0, 0, true)
+	cash := NewAsset("Cash", 4, 0, 0, 0, 0, true)
+	excluded := NewAsset("Excluded", 30, 0, 0, 0, 0, false)
+	group := NewAssetGroup("retirement", []*Asset{equities, bonds, cash, excluded}, 0)
+
+	targets := group.TargetsAt(date(2050, 1)).Targets
+	assert.EqualValues(40, targets[0].Score)
+	assert.EqualValues(36, targets[1].Score)
+	assert.EqualValues(24, targets[2].Score)
+	assert.EqualValues(30, targets[3].Score)
+
+	equities.GlidePath.Points[1].Score = 100
+	bonds.GlidePath, _ = NewGlidePath("", []*GlidePathPoint{{At: date(2026, 1), Score: 25}})
+	targets = group.TargetsAt(date(2050, 1)).Targets
+	assert.EqualValues(80, targets[0].Score)
+	assert.EqualValues(20, targets[1].Score)
+	assert.Zero(targets[2].Score)
+}
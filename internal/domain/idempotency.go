@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// IdempotencyRecord keeps the response to a request sent with an
+	// Idempotency-Key, so a retry of it is answered the same way instead
+	// of being carried out again. A zero StatusCode means the first
+	// request is still being served, until ExpiresAt at most.
+	IdempotencyRecord struct {
+		Id          uuid.UUID
+		Key         string
+		Principal   string
+		RequestHash string
+		StatusCode  int
+		ContentType string
+		Location    string
+		Body        []byte
+		CreatedAt   time.Time
+		ExpiresAt   time.Time
+	}
+)
+
+func NewIdempotencyRecord(key, principal, requestHash string, ttl time.Duration) *IdempotencyRecord {
+	now := time.Now()
+	return &IdempotencyRecord{
+		Id:          uuid.New(),
+		Key:         key,
+		Principal:   principal,
+		RequestHash: requestHash,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(ttl),
+	}
+}
+
+func (r *IdempotencyRecord) IsPending() bool {
+	return r.StatusCode == 0
+}
+
+func (r *IdempotencyRecord) IsExpired(now time.Time) bool {
+	return !now.Before(r.ExpiresAt)
+}
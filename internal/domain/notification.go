@@ -0,0 +1,177 @@
+package domain
+
+import (
+	"math"
+	"net/url"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	NOTIFICATION_ASSET_DRIFTED  = "ASSET_DRIFTED"
+	NOTIFICATION_PLAN_GENERATED = "PLAN_GENERATED"
+	NOTIFICATION_GROUP_MODIFIED = "GROUP_MODIFIED"
+
+	NOTIFICATION_CHANNEL_WEBHOOK = "WEBHOOK"
+	NOTIFICATION_CHANNEL_EMAIL   = "EMAIL"
+)
+
+var notificationEvents = []string{
+	NOTIFICATION_ASSET_DRIFTED,
+	NOTIFICATION_PLAN_GENERATED,
+	NOTIFICATION_GROUP_MODIFIED,
+}
+
+type (
+	NotificationSubscription struct {
+		Id             uuid.UUID
+		GroupId        uuid.UUID
+		Channel        string
+		Target         string
+		Secret         string `json:"-"`
+		Events         []string
+		DriftThreshold float64
+		// DriftedAssetIds are the assets drifted at the last alert, so
+		// one is only raised again when they change.
+		DriftedAssetIds []uuid.UUID `json:",omitempty"`
+	}
+	Notification struct {
+		Id         uuid.UUID
+		Event      string
+		GroupId    uuid.UUID
+		OccurredAt time.Time
+		Payload    interface{}
+	}
+	NotificationDelivery struct {
+		Id             uuid.UUID
+		SubscriptionId uuid.UUID
+		NotificationId uuid.UUID
+		GroupId        uuid.UUID
+		Event          string
+		Channel        string
+		Target         string
+		Attempts       int
+		Delivered      bool
+		LastError      string
+		CreatedAt      time.Time
+		FinishedAt     time.Time
+	}
+	AssetDrift struct {
+		AssetId    uuid.UUID
+		Label      string
+		Score      float32
+		Percentage float64
+		Drift      float64
+	}
+)
+
+func NewNotificationSubscription(
+	groupId uuid.UUID, channel, target, secret string,
+	events []string, driftThreshold float64) (*NotificationSubscription, error) {
+	if channel != NOTIFICATION_CHANNEL_WEBHOOK && channel != NOTIFICATION_CHANNEL_EMAIL {
+		return nil, ErrInvalidNotificationChannel
+	}
+	if target == "" {
+		return nil, ErrInvalidNotificationTarget
+	}
+	if channel == NOTIFICATION_CHANNEL_WEBHOOK {
+		u, err := url.Parse(target)
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Hostname() == "" || u.User != nil {
+			return nil, ErrInvalidNotificationTarget
+		}
+	}
+	if len(events) == 0 {
+		return nil, ErrInvalidNotificationEvent
+	}
+	for _, e := range events {
+		if !slices.Contains(notificationEvents, e) {
+			return nil, ErrInvalidNotificationEvent
+		}
+	}
+	if slices.Contains(events, NOTIFICATION_ASSET_DRIFTED) && driftThreshold <= 0 {
+		return nil, ErrInvalidDriftThreshold
+	}
+
+	return &NotificationSubscription{
+		Id:             uuid.New(),
+		GroupId:        groupId,
+		Channel:        channel,
+		Target:         target,
+		Secret:         secret,
+		Events:         events,
+		DriftThreshold: driftThreshold,
+	}, nil
+}
+
+func (ns *NotificationSubscription) Wants(event string) bool {
+	return slices.Contains(ns.Events, event)
+}
+
+// TrackDrift remembers which assets are drifted and tells whether they
+// changed since it was last called. An alert is only due then.
+func (ns *NotificationSubscription) TrackDrift(drifts []*AssetDrift) bool {
+	ids := make([]uuid.UUID, len(drifts))
+	for i, d := range drifts {
+		ids[i] = d.AssetId
+	}
+	changed := len(ids) != len(ns.DriftedAssetIds)
+	for _, id := range ids {
+		changed = changed || !slices.Contains(ns.DriftedAssetIds, id)
+	}
+	ns.DriftedAssetIds = ids
+
+	return changed
+}
+
+func NewNotification(event string, groupId uuid.UUID, payload interface{}, now time.Time) *Notification {
+	return &Notification{
+		Id:         uuid.New(),
+		Event:      event,
+		GroupId:    groupId,
+		OccurredAt: now,
+		Payload:    payload,
+	}
+}
+
+func NewNotificationDelivery(s *NotificationSubscription, n *Notification, now time.Time) *NotificationDelivery {
+	return &NotificationDelivery{
+		Id:             uuid.New(),
+		SubscriptionId: s.Id,
+		NotificationId: n.Id,
+		GroupId:        n.GroupId,
+		Event:          n.Event,
+		Channel:        s.Channel,
+		Target:         s.Target,
+		CreatedAt:      now,
+	}
+}
+
+// Drift is how far, in percentage points, the asset is from its score.
+func (a *Asset) Drift(currentTotal float64) float64 {
+	return a.CalculatePercentageFromTotal(currentTotal)*100 - float64(a.Score)
+}
+
+// DriftedAssets lists the included assets whose drift is beyond the
+// threshold, in percentage points, in either direction.
+func (ag *AssetsGroup) DriftedAssets(threshold float64) []*AssetDrift {
+	total := ag.CurrentTotal()
+	result := []*AssetDrift{}
+	for _, a := range ag.Assets {
+		if !a.Include {
+			continue
+		}
+		if d := a.Drift(total); math.Abs(d) > threshold {
+			result = append(result, &AssetDrift{
+				AssetId:    a.Id,
+				Label:      a.Label,
+				Score:      a.Score,
+				Percentage: a.CalculatePercentageFromTotal(total) * 100,
+				Drift:      d,
+			})
+		}
+	}
+
+	return result
+}
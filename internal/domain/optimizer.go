@@ -0,0 +1,331 @@
+package domain
+
+import (
+	"math"
+)
+
+const (
+	REBALANCE_MODE_PROPORTIONAL = "PROPORTIONAL"
+	REBALANCE_MODE_OPTIMIZED    = "OPTIMIZED"
+)
+
+// OPTIMIZATION_MAX_MOVES warns that the optimizer ran out of moves before
+// settling, so better trades may be left.
+const OPTIMIZATION_MAX_MOVES = "MAX_MOVES"
+
+const (
+	// optimizerGranularity is the smallest amount the optimizer moves for
+	// assets that don't trade in lots.
+	optimizerGranularity = 0.01
+	// optimizerCostAversion weighs the costs, as a share of the group,
+	// against the squared tracking error.
+	optimizerCostAversion = 1.
+	// optimizerWeightPenalty weighs the squared breach of a min or max
+	// weight, so the optimizer breaks one only when no lot keeps it.
+	optimizerWeightPenalty = 1e4
+	optimizerMaxMoves      = 100000
+)
+
+type (
+	// TradingCosts are what buying or selling the asset costs, and the
+	// lots it trades in.
+	TradingCosts struct {
+		// FixedCost is paid once on every trade.
+		FixedCost float64
+		// ProportionalCost is the share of the amount traded paid on top,
+		// 0.001 for 0.1%.
+		ProportionalCost float64
+		// LotSize is the number of shares the asset trades in, priced from
+		// its current value and quantity. Zero, or an asset without a
+		// quantity, trades any amount.
+		LotSize float64
+	}
+	// OptimizationResult sums up the trades the optimizer settled on.
+	OptimizationResult struct {
+		// TrackingError is how far the weights end up from the scores, in
+		// percentage points.
+		TrackingError    float64
+		TransactionCosts float64
+		Trades           int
+		Moves            int
+		// Warning tells why the trades may not be the best ones, as
+		// OPTIMIZATION_MAX_MOVES.
+		Warning string `json:",omitempty"`
+	}
+	// optimizer searches the trades of the included assets. Each trade
+	// is a whole number of units, the lot value or the current step, and
+	// every move is the one that lowers the objective the most, so the
+	// search is deterministic.
+	optimizer struct {
+		assets []*Asset
+		total  float64
+		budget float64
+		// saleLimits caps the sale of each asset, at the part realizing
+		// no gain in tax aware groups.
+		saleLimits []float64
+		trades     []float64
+		costs      []float64
+		spent      float64
+	}
+)
+
+func NewTradingCosts(fixedCost, proportionalCost, lotSize float64) (*TradingCosts, error) {
+	if fixedCost < 0 || proportionalCost < 0 || proportionalCost >= 1 || lotSize < 0 {
+		return nil, ErrInvalidTradingCosts
+	}
+
+	return &TradingCosts{
+		FixedCost:        fixedCost,
+		ProportionalCost: proportionalCost,
+		LotSize:          lotSize,
+	}, nil
+}
+
+// Rebalance works out the final contributions in the mode of the group.
+func (ag *AssetsGroup) Rebalance() {
+	if ag.RebalanceMode == REBALANCE_MODE_OPTIMIZED {
+		ag.Optimize()
+		return
+	}
+	for _, a := range ag.Assets {
+		a.TransactionCost = 0
+	}
+	ag.Optimization = nil
+	ag.DistributeContribution()
+}
+
+// Optimize picks the trades that minimize the squared tracking error
+// against the scores plus the trading costs, within the contribution. It
+// starts from no trades and moves by whole lots, first in coarse steps
+// then finer ones, until no single trade, nor any trade against another,
+// improves on it. Selling more than held, and breaking the never sell,
+// never buy or min trade constraints, are ruled out, as are the sales
+// realizing gains of tax aware groups; min and max weights are heavily
+// penalized instead, since whole lots may not meet them. The contribution
+// left unspent counts as cash with a zero target. The assets are bound
+// by the constraints that keep them from what their score asks for, as
+// when distributing.
+func (ag *AssetsGroup) Optimize() {
+	currentTotal := ag.CurrentTotal()
+	o := &optimizer{
+		total:  currentTotal + ag.ContributionTotal,
+		budget: ag.ContributionTotal,
+	}
+	for _, a := range ag.Assets {
+		a.Binding = nil
+		a.TransactionCost = 0
+		if a.Include {
+			a.FinalContribution = 0
+			o.assets = append(o.assets, a)
+			limit := a.CurrentValue
+			if ag.TaxAware {
+				limit = a.gainFreeSale(limit, ag.LotSelection)
+			}
+			o.saleLimits = append(o.saleLimits, limit)
+		}
+	}
+	o.trades = make([]float64, len(o.assets))
+	o.costs = make([]float64, len(o.assets))
+
+	moves := 0
+	if o.total > 0 {
+		step := optimizerGranularity
+		for step < o.total/2 {
+			step *= 2
+		}
+		for ; step >= optimizerGranularity && moves < optimizerMaxMoves; step /= 2 {
+			for moves < optimizerMaxMoves && o.move(step) {
+				moves++
+			}
+		}
+	}
+
+	result := &OptimizationResult{
+		Moves: moves,
+	}
+	if moves >= optimizerMaxMoves {
+		result.Warning = OPTIMIZATION_MAX_MOVES
+	}
+	deviation := 0.
+	for i, a := range o.assets {
+		a.FinalContribution = o.trades[i]
+		a.TransactionCost = o.costs[i]
+		target := a.CalculateFinalContribution(ag.ContributionTotal, currentTotal)
+		if _, constraint := ag.constrain(a, target, o.total); constraint != "" {
+			a.Binding = newConstraintBinding(a, constraint, target)
+		}
+		result.TransactionCosts += o.costs[i]
+		if o.trades[i] != 0 {
+			result.Trades++
+		}
+		if o.total > 0 {
+			deviation += math.Pow((a.CurrentValue+o.trades[i])/o.total-float64(a.Score)/100, 2)
+		}
+	}
+	result.TrackingError = math.Sqrt(deviation) * 100
+	ag.Optimization = result
+	ag.UnallocatedContribution = ag.ContributionTotal - o.spent
+	if math.Abs(ag.UnallocatedContribution) < 0.005 {
+		ag.UnallocatedContribution = 0
+	}
+}
+
+// move makes the best improving move at the given step, telling whether
+// there was one.
+func (o *optimizer) move(step float64) bool {
+	type candidate struct {
+		i, j   int
+		ti, tj float64
+	}
+	best, bestDelta := candidate{}, -1e-12
+	found := false
+	try := func(c candidate) {
+		c.ti, c.tj = snap(c.ti), snap(c.tj)
+		delta, ok := o.delta(c.i, c.ti, c.j, c.tj)
+		if ok && delta < bestDelta {
+			best, bestDelta, found = c, delta, true
+		}
+	}
+
+	for i := range o.assets {
+		unit := o.unit(i, step)
+		for _, t := range o.targets(i, unit) {
+			try(candidate{i: i, ti: t, j: -1})
+		}
+		for j := range o.assets {
+			if j != i {
+				try(candidate{i: i, ti: o.trades[i] + unit, j: j, tj: o.trades[j] - o.unit(j, step)})
+			}
+		}
+	}
+	if !found {
+		return false
+	}
+	o.apply(best.i, best.ti)
+	if best.j >= 0 {
+		o.apply(best.j, best.tj)
+	}
+
+	return true
+}
+
+// targets lists the trades an asset may move to on its own: a unit up or
+// down, none at all, and the smallest trade allowed when it has none.
+func (o *optimizer) targets(i int, unit float64) []float64 {
+	t := o.trades[i]
+	targets := []float64{t + unit, t - unit}
+	if t != 0 {
+		targets = append(targets, 0)
+	}
+	if k := o.assets[i].Constraints; k != nil && t == 0 && k.MinTrade > unit {
+		smallest := math.Ceil(k.MinTrade/unit) * unit
+		targets = append(targets, smallest, -smallest)
+	}
+
+	return targets
+}
+
+// unit is what the asset moves by: the whole number of lots closest to
+// the step, one at least, or the step when it doesn't trade in lots or
+// has no price to value them at.
+func (o *optimizer) unit(i int, step float64) float64 {
+	a := o.assets[i]
+	if a.TradingCosts == nil || a.TradingCosts.LotSize <= 0 || a.Quantity <= 0 {
+		return step
+	}
+	lot := a.TradingCosts.LotSize * a.UnitPrice()
+	if lot <= 0 {
+		return step
+	}
+
+	return math.Max(1, math.Round(step/lot)) * lot
+}
+
+// delta tells how the objective changes when asset i trades ti instead,
+// along with asset j trading tj when j isn't negative, and whether the
+// outcome is allowed at all.
+func (o *optimizer) delta(i int, ti float64, j int, tj float64) (float64, bool) {
+	if !o.allowed(i, ti) {
+		return 0, false
+	}
+	costI := o.cost(i, ti)
+	delta := o.assetObjective(i, ti, costI) - o.assetObjective(i, o.trades[i], o.costs[i])
+	spent := o.spent + ti + costI - o.trades[i] - o.costs[i]
+	if j >= 0 {
+		if !o.allowed(j, tj) {
+			return 0, false
+		}
+		costJ := o.cost(j, tj)
+		delta += o.assetObjective(j, tj, costJ) - o.assetObjective(j, o.trades[j], o.costs[j])
+		spent += tj + costJ - o.trades[j] - o.costs[j]
+	}
+	if spent > o.budget+1e-9 {
+		return 0, false
+	}
+
+	return delta + o.cashObjective(spent) - o.cashObjective(o.spent), true
+}
+
+func (o *optimizer) allowed(i int, trade float64) bool {
+	a := o.assets[i]
+	if -trade > o.saleLimits[i]+1e-9 {
+		return false
+	}
+	k := a.Constraints
+	if k == nil {
+		return true
+	}
+
+	return !(k.NeverSell && trade < 0) && !(k.NeverBuy && trade > 0) &&
+		!(trade != 0 && math.Abs(trade) < k.MinTrade-1e-9)
+}
+
+func (o *optimizer) cost(i int, trade float64) float64 {
+	k := o.assets[i].TradingCosts
+	if k == nil || trade == 0 {
+		return 0
+	}
+
+	return k.FixedCost + k.ProportionalCost*math.Abs(trade)
+}
+
+// assetObjective is the share of the objective an asset accounts for:
+// the squared distance of its weight from its score, its costs and any
+// breach of its weights.
+func (o *optimizer) assetObjective(i int, trade, cost float64) float64 {
+	a := o.assets[i]
+	weight := (a.CurrentValue + trade) / o.total
+	objective := math.Pow(weight-float64(a.Score)/100, 2) + optimizerCostAversion*cost/o.total
+	if k := a.Constraints; k != nil {
+		if k.MinWeight != nil && weight < float64(*k.MinWeight)/100 {
+			objective += optimizerWeightPenalty * math.Pow(float64(*k.MinWeight)/100-weight, 2)
+		}
+		if k.MaxWeight != nil && weight > float64(*k.MaxWeight)/100 {
+			objective += optimizerWeightPenalty * math.Pow(weight-float64(*k.MaxWeight)/100, 2)
+		}
+	}
+
+	return objective
+}
+
+// cashObjective counts the unspent contribution as an asset aiming for
+// a zero weight.
+func (o *optimizer) cashObjective(spent float64) float64 {
+	return math.Pow((o.budget-spent)/o.total, 2)
+}
+
+func (o *optimizer) apply(i int, trade float64) {
+	cost := o.cost(i, trade)
+	o.spent += trade + cost - o.trades[i] - o.costs[i]
+	o.trades[i] = trade
+	o.costs[i] = cost
+}
+
+// snap takes a trade that steps back and forth left a rounding error
+// from zero back to none.
+func snap(trade float64) float64 {
+	if math.Abs(trade) < 1e-9 {
+		return 0
+	}
+	return trade
+}
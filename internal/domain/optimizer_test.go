@@ -0,0 +1,143 @@
+package domain
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newOptimizedGroup(contribution float64, assets ...*Asset) *AssetsGroup {
+	group := NewAssetGroup("optimized", assets, contribution)
+	group.RebalanceMode = REBALANCE_MODE_OPTIMIZED
+	return group
+}
+
+func Test_Should_OptimizeTowardsTheScoresWithoutCosts(t *testing.T) {
+	assert := assert.New(t)
+	group := newOptimizedGroup(200,
+		NewAsset("RF", 50, 0, 100, 0, 0, true),
+		NewAsset("RV", 50, 0, 300, 0, 0, true))
+
+	group.Rebalance()
+
+	assert.InDelta(200, group.Assets[0].FinalContribution, 0.01)
+	assert.InDelta(0, group.Assets[1].FinalContribution, 0.01)
+	assert.InDelta(0, group.Optimization.TrackingError, 0.01)
+	assert.Zero(group.UnallocatedContribution)
+}
+
+func Test_Should_TradeWholeLots(t *testing.T) {
+	assert := assert.New(t)
+	cheap := NewAsset("Cheap", 50, 0, 30, 0, 0, true)
+	cheap.Quantity = 1
+	cheap.TradingCosts, _ = NewTradingCosts(0, 0, 1)
+	dear := NewAsset("Dear", 50, 0, 70, 0, 0, true)
+	dear.Quantity = 1
+	dear.TradingCosts, _ = NewTradingCosts(0, 0, 1)
+	group := newOptimizedGroup(1000, cheap, dear)
+
+	group.Rebalance()
+
+	assert.InDelta(510, cheap.FinalContribution, 1e-6)
+	assert.InDelta(490, dear.FinalContribution, 1e-6)
+	assert.Equal(2, group.Optimization.Trades)
+
+	plan := NewRebalancePlan(group, uuid.New(), 1000, time.Now())
+	if assert.Len(plan.Items, 2) {
+		assert.InDelta(510, plan.Items[0].Amount, 1e-6)
+		assert.InDelta(490, plan.Items[1].Amount, 1e-6)
+	}
+}
+
+func Test_Should_MoveByTheLotMultipleClosestToTheStep(t *testing.T) {
+	assert := assert.New(t)
+	lots := NewAsset("Lots", 50, 0, 3, 0, 0, true)
+	lots.Quantity = 1
+	lots.TradingCosts, _ = NewTradingCosts(0, 0, 1)
+	unpriced := NewAsset("Unpriced", 50, 0, 0, 0, 0, true)
+	unpriced.Quantity = 5
+	unpriced.TradingCosts, _ = NewTradingCosts(0, 0, 1)
+	o := &optimizer{assets: []*Asset{lots, unpriced}}
+
+	assert.EqualValues(9, o.unit(0, 10))
+	assert.EqualValues(3, o.unit(0, 0.5))
+	assert.EqualValues(0.5, o.unit(1, 0.5))
+
+	unpriced.Constraints, _ = NewAssetConstraints(nil, nil, 10, false, false)
+	group := newOptimizedGroup(100, lots, unpriced)
+	group.Rebalance()
+	assert.False(math.IsNaN(unpriced.FinalContribution))
+	assert.InDelta(100, lots.FinalContribution+unpriced.FinalContribution, 3)
+}
+
+func Test_Should_BindOptimizedAssetsByTheirConstraints(t *testing.T) {
+	assert := assert.New(t)
+	held := NewAsset("Held", 50, 0, 0, 0, 0, true)
+	held.Constraints, _ = NewAssetConstraints(nil, nil, 0, false, true)
+	free := NewAsset("Free", 50, 0, 0, 0, 0, true)
+	group := newOptimizedGroup(100, held, free)
+
+	group.Rebalance()
+
+	assert.Zero(held.FinalContribution)
+	if assert.NotNil(held.Binding) {
+		assert.Equal(CONSTRAINT_NEVER_BUY, held.Binding.Constraint)
+		assert.EqualValues(50, held.Binding.TargetContribution)
+	}
+	assert.Nil(free.Binding)
+	assert.Empty(group.Optimization.Warning)
+}
+
+func Test_Should_SkipTradesThatCostMoreThanTheyTrack(t *testing.T) {
+	assert := assert.New(t)
+	newGroup := func() *AssetsGroup {
+		return newOptimizedGroup(0,
+			NewAsset("RF", 50, 0, 500, 0, 0, true),
+			NewAsset("RV", 50, 0, 520, 0, 0, true))
+	}
+
+	free := newGroup()
+	free.Rebalance()
+	assert.InDelta(10, free.Assets[0].FinalContribution, 0.01)
+	assert.InDelta(-10, free.Assets[1].FinalContribution, 0.01)
+
+	costly := newGroup()
+	for _, a := range costly.Assets {
+		a.TradingCosts, _ = NewTradingCosts(5, 0.001, 0)
+	}
+	costly.Rebalance()
+	assert.Zero(costly.Optimization.Trades)
+	assert.Zero(costly.Optimization.TransactionCosts)
+
+	held := newGroup()
+	held.Assets[1].Constraints, _ = NewAssetConstraints(nil, nil, 0, true, false)
+	held.Rebalance()
+	assert.Zero(held.Optimization.Trades)
+}
+
+func Test_Should_PayTradingCostsOutOfTheContribution(t *testing.T) {
+	assert := assert.New(t)
+	a := NewAsset("RF", 100, 0, 0, 0, 0, true)
+	a.TradingCosts, _ = NewTradingCosts(10, 0.01, 0)
+	group := newOptimizedGroup(1010, a)
+
+	group.Rebalance()
+
+	assert.InDelta(990.10, a.FinalContribution, 0.02)
+	assert.InDelta(19.90, a.TransactionCost, 0.02)
+	assert.InDelta(0, a.FinalContribution+a.TransactionCost-1010, 0.01)
+}
+
+func Test_Should_RejectInvalidTradingCosts(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := NewTradingCosts(-1, 0, 0)
+	assert.EqualError(err, INVALID_TRADING_COSTS)
+	_, err = NewTradingCosts(0, 1, 0)
+	assert.EqualError(err, INVALID_TRADING_COSTS)
+	_, err = NewTradingCosts(0, 0, -1)
+	assert.EqualError(err, INVALID_TRADING_COSTS)
+}
@@ -0,0 +1,11 @@
+package domain
+
+import "time"
+
+type (
+	AssetPrice struct {
+		Ticker    string
+		Price     float64
+		UpdatedAt time.Time
+	}
+)
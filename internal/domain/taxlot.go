@@ -0,0 +1,240 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+)
+
+const (
+	LOT_SELECTION_FIFO         = "FIFO"
+	LOT_SELECTION_LIFO         = "LIFO"
+	LOT_SELECTION_HIGHEST_COST = "HIGHEST_COST"
+	LOT_SELECTION_LOWEST_COST  = "LOWEST_COST"
+)
+
+type (
+	// TaxLot is a purchase of the asset still held, with what it cost.
+	TaxLot struct {
+		Id         uuid.UUID
+		Quantity   float64
+		CostBasis  float64
+		AcquiredAt time.Time
+	}
+	// SaleEstimate is what selling the final contribution of an asset is
+	// expected to realize, taking the lots in the order of the group.
+	SaleEstimate struct {
+		Quantity      float64
+		Proceeds      float64
+		CostBasis     float64
+		RealizedGain  float64
+		ShortTermGain float64
+		LongTermGain  float64
+		// UncoveredQuantity is the part of the sale no lot accounts for,
+		// whose gain is unknown.
+		UncoveredQuantity float64 `json:",omitempty"`
+		Lots              []*LotSale
+	}
+	LotSale struct {
+		LotId     uuid.UUID
+		Quantity  float64
+		CostBasis float64
+		Gain      float64
+		LongTerm  bool
+	}
+)
+
+func NewTaxLot(quantity, costBasis float64, acquiredAt time.Time) (*TaxLot, error) {
+	if quantity <= 0 || costBasis < 0 || acquiredAt.IsZero() {
+		return nil, ErrInvalidTaxLot
+	}
+
+	return &TaxLot{
+		Id:         uuid.New(),
+		Quantity:   quantity,
+		CostBasis:  costBasis,
+		AcquiredAt: acquiredAt,
+	}, nil
+}
+
+func (tl *TaxLot) UnitCost() float64 {
+	return tl.CostBasis / tl.Quantity
+}
+
+// AddTaxLot appends the lot, unless the lots would hold more shares than
+// the quantity of the asset, when it has one.
+func (a *Asset) AddTaxLot(tl *TaxLot) error {
+	if !a.holds(a.Quantity, tl.Quantity) {
+		return ErrInvalidTaxLot
+	}
+	a.TaxLots = append(slices.Clone(a.TaxLots), tl)
+
+	return nil
+}
+
+// SetQuantity changes the quantity held, unless it would be less than
+// what the lots hold. No quantity leaves the lots unchecked, as it does
+// when they're added.
+func (a *Asset) SetQuantity(quantity float64) error {
+	if !a.holds(quantity, 0) {
+		return ErrInvalidTaxLot
+	}
+	a.Quantity = quantity
+
+	return nil
+}
+
+// holds tells whether quantity covers the lots and the extra shares.
+func (a *Asset) holds(quantity, extra float64) bool {
+	if quantity <= 0 {
+		return true
+	}
+	held := extra
+	for _, l := range a.TaxLots {
+		held += l.Quantity
+	}
+	return held <= quantity+1e-9
+}
+
+// RemoveTaxLot tells whether the asset held the lot.
+func (a *Asset) RemoveTaxLot(id uuid.UUID) bool {
+	idx := slices.IndexFunc(a.TaxLots, func(tl *TaxLot) bool {
+		return tl.Id == id
+	})
+	if idx < 0 {
+		return false
+	}
+	a.TaxLots = slices.Delete(slices.Clone(a.TaxLots), idx, idx+1)
+
+	return true
+}
+
+// UnitPrice is the current price of a share, from the quantity held or,
+// failing that, from the lots.
+func (a *Asset) UnitPrice() float64 {
+	quantity := a.Quantity
+	if quantity <= 0 {
+		for _, tl := range a.TaxLots {
+			quantity += tl.Quantity
+		}
+	}
+	if quantity <= 0 {
+		return 0
+	}
+
+	return a.CurrentValue / quantity
+}
+
+// UnrealizedGain is what selling every lot at the current price would
+// realize. It's zero for an asset without lots, whose gains are unknown.
+func (a *Asset) UnrealizedGain() float64 {
+	price := a.UnitPrice()
+	gain := 0.
+	for _, tl := range a.TaxLots {
+		gain += tl.Quantity*price - tl.CostBasis
+	}
+
+	return gain
+}
+
+// EstimateSales works out the gains each sale suggested for the group
+// would realize on the given date, and their total. Assets that aren't
+// sold have no estimate.
+func (ag *AssetsGroup) EstimateSales(now time.Time) {
+	ag.EstimatedRealizedGain = 0
+	for _, a := range ag.Assets {
+		a.SaleEstimate = nil
+		if !a.Include || a.FinalContribution >= 0 {
+			continue
+		}
+		a.SaleEstimate = a.estimateSale(-a.FinalContribution, ag.LotSelection, now)
+		ag.EstimatedRealizedGain += a.SaleEstimate.RealizedGain
+	}
+}
+
+func (a *Asset) estimateSale(amount float64, selection string, now time.Time) *SaleEstimate {
+	estimate := &SaleEstimate{
+		Proceeds: amount,
+		Lots:     []*LotSale{},
+	}
+	price := a.UnitPrice()
+	if price <= 0 {
+		return estimate
+	}
+	estimate.Quantity = amount / price
+
+	remaining := estimate.Quantity
+	for _, tl := range selectLots(a.TaxLots, selection) {
+		if remaining <= 0 {
+			break
+		}
+		quantity := tl.Quantity
+		if quantity > remaining {
+			quantity = remaining
+		}
+		remaining -= quantity
+		sale := &LotSale{
+			LotId:     tl.Id,
+			Quantity:  quantity,
+			CostBasis: quantity * tl.UnitCost(),
+			LongTerm:  now.After(tl.AcquiredAt.AddDate(1, 0, 0)),
+		}
+		sale.Gain = quantity*price - sale.CostBasis
+		estimate.Lots = append(estimate.Lots, sale)
+		estimate.CostBasis += sale.CostBasis
+		estimate.RealizedGain += sale.Gain
+		if sale.LongTerm {
+			estimate.LongTermGain += sale.Gain
+		} else {
+			estimate.ShortTermGain += sale.Gain
+		}
+	}
+	if remaining > 1e-9 {
+		estimate.UncoveredQuantity = remaining
+	}
+
+	return estimate
+}
+
+// gainFreeSale is the part of a sale of amount that realizes no gain
+// overall, selling the lots in the order of selection until the gain of
+// one would outweigh the losses of those before it.
+func (a *Asset) gainFreeSale(amount float64, selection string) float64 {
+	// the date only tells long from short term gains, which don't matter
+	estimate := a.estimateSale(amount, selection, time.Time{})
+	if estimate.RealizedGain <= 0 {
+		return amount
+	}
+	quantity, gain := 0., 0.
+	for _, sale := range estimate.Lots {
+		if gain+sale.Gain > 0 {
+			quantity += -gain * sale.Quantity / sale.Gain
+			break
+		}
+		quantity += sale.Quantity
+		gain += sale.Gain
+	}
+
+	return quantity * a.UnitPrice()
+}
+
+// selectLots orders the lots the way they're sold: oldest or newest
+// first, or dearest or cheapest first. FIFO is the default.
+func selectLots(lots []*TaxLot, selection string) []*TaxLot {
+	ordered := slices.Clone(lots)
+	slices.SortStableFunc(ordered, func(a, b *TaxLot) bool {
+		switch selection {
+		case LOT_SELECTION_LIFO:
+			return a.AcquiredAt.After(b.AcquiredAt)
+		case LOT_SELECTION_HIGHEST_COST:
+			return a.UnitCost() > b.UnitCost()
+		case LOT_SELECTION_LOWEST_COST:
+			return a.UnitCost() < b.UnitCost()
+		default:
+			return a.AcquiredAt.Before(b.AcquiredAt)
+		}
+	})
+
+	return ordered
+}
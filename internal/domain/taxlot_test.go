@@ -0,0 +1,156 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func newLottedAsset(score float32, lots ...*TaxLot) *Asset {
+	a := NewAsset("Stocks", score, 0, 0, 0, 0, true)
+	for _, tl := range lots {
+		a.Quantity += tl.Quantity
+		a.AddTaxLot(tl)
+	}
+	a.CurrentValue = a.Quantity * 20
+	return a
+}
+
+func Test_Should_EstimateTheGainsOfSalesByLotSelection(t *testing.T) {
+	assert := assert.New(t)
+	now := date(2026, 10)
+	old, _ := NewTaxLot(10, 100, date(2020, 1))
+	recent, _ := NewTaxLot(10, 150, date(2026, 6))
+	dear, _ := NewTaxLot(10, 250, date(2025, 1))
+
+	for selection, gain := range map[string]struct{ short, long float64 }{
+		LOT_SELECTION_FIFO:         {0, 100 - 25},
+		"":                         {0, 100 - 25},
+		LOT_SELECTION_LIFO:         {50, -25},
+		LOT_SELECTION_HIGHEST_COST: {25, -50},
+		LOT_SELECTION_LOWEST_COST:  {25, 100},
+	} {
+		stocks := newLottedAsset(0, old, recent, dear)
+		group := NewAssetGroup("test", []*Asset{stocks, NewAsset("Cash", 100, 0, 0, 0, 0, true)}, 0)
+		group.LotSelection = selection
+		stocks.FinalContribution = -300
+
+		group.EstimateSales(now)
+
+		estimate := stocks.SaleEstimate
+		if assert.NotNil(estimate, selection) {
+			assert.InDelta(15, estimate.Quantity, 1e-9, selection)
+			assert.InDelta(gain.short, estimate.ShortTermGain, 1e-9, selection)
+			assert.InDelta(gain.long, estimate.LongTermGain, 1e-9, selection)
+			assert.InDelta(gain.short+gain.long, group.EstimatedRealizedGain, 1e-9, selection)
+			assert.Len(estimate.Lots, 2, selection)
+		}
+		assert.Nil(group.Assets[1].SaleEstimate)
+	}
+}
+
+func Test_Should_TellTheSaleLotsDontCover(t *testing.T) {
+	assert := assert.New(t)
+	lot, _ := NewTaxLot(5, 50, date(2020, 1))
+	stocks := newLottedAsset(0, lot)
+	stocks.Quantity = 10
+	stocks.CurrentValue = 200
+	stocks.FinalContribution = -160
+
+	estimate := stocks.estimateSale(160, LOT_SELECTION_FIFO, date(2026, 10))
+
+	assert.InDelta(8, estimate.Quantity, 1e-9)
+	assert.InDelta(3, estimate.UncoveredQuantity, 1e-9)
+	assert.InDelta(50, estimate.RealizedGain, 1e-9)
+}
+
+func Test_Should_AvoidSellingGainsInTaxAwareGroups(t *testing.T) {
+	assert := assert.New(t)
+	gainLot, _ := NewTaxLot(50, 500, date(2020, 1))
+	lossLot, _ := NewTaxLot(50, 2000, date(2020, 1))
+	gains := newLottedAsset(25, gainLot)
+	losses := newLottedAsset(25, lossLot)
+	bonds := NewAsset("Bonds", 50, 0, 0, 0, 0, true)
+	group := NewAssetGroup("test", []*Asset{gains, losses, bonds}, 0)
+
+	group.DistributeContribution()
+	assert.InDelta(-500, gains.FinalContribution, 1e-9)
+	assert.InDelta(-500, losses.FinalContribution, 1e-9)
+
+	group.TaxAware = true
+	group.DistributeContribution()
+	assert.Zero(gains.FinalContribution)
+	assert.Equal(CONSTRAINT_AVOID_GAINS, gains.Binding.Constraint)
+	// the sale held back is taken from the others by score
+	assert.InDelta(-500-500./3, losses.FinalContribution, 1e-9)
+	assert.InDelta(1000-1000./3, bonds.FinalContribution, 1e-9)
+}
+
+func Test_Should_OnlyHoldBackTheSaleThatRealizesGains(t *testing.T) {
+	assert := assert.New(t)
+	lossLot, _ := NewTaxLot(10, 300, date(2020, 1))
+	gainLot, _ := NewTaxLot(20, 200, date(2021, 1))
+	stocks := newLottedAsset(0, lossLot, gainLot)
+	group := NewAssetGroup("test", []*Asset{stocks}, 0)
+	group.TaxAware = true
+
+	contribution, constraint := group.constrain(stocks, -600, 600)
+	assert.InDelta(-400, contribution, 1e-9)
+	assert.Equal(CONSTRAINT_AVOID_GAINS, constraint)
+
+	contribution, constraint = group.constrain(stocks, -100, 600)
+	assert.InDelta(-100, contribution, 1e-9)
+	assert.Empty(constraint)
+
+	group.LotSelection = LOT_SELECTION_LIFO
+	contribution, _ = group.constrain(stocks, -600, 600)
+	assert.Zero(contribution)
+}
+
+func Test_Should_CountGainsAsLongTermAfterAYear(t *testing.T) {
+	assert := assert.New(t)
+	lot, _ := NewTaxLot(10, 100, date(2025, 3))
+	stocks := newLottedAsset(0, lot)
+
+	sale := stocks.estimateSale(200, LOT_SELECTION_FIFO, date(2026, 3))
+	assert.False(sale.Lots[0].LongTerm)
+	sale = stocks.estimateSale(200, LOT_SELECTION_FIFO, date(2026, 3).Add(time.Second))
+	assert.True(sale.Lots[0].LongTerm)
+}
+
+func Test_Should_Not_AddMoreLotsThanTheQuantityHeld(t *testing.T) {
+	assert := assert.New(t)
+	lot, _ := NewTaxLot(10, 100, date(2025, 3))
+	stocks := newLottedAsset(0, lot)
+	more, _ := NewTaxLot(1, 10, date(2025, 4))
+
+	assert.EqualError(stocks.AddTaxLot(more), INVALID_TAX_LOT)
+	assert.Len(stocks.TaxLots, 1)
+
+	stocks.Quantity = 0
+	assert.Nil(stocks.AddTaxLot(more))
+	assert.Len(stocks.TaxLots, 2)
+}
+
+func Test_Should_Not_HoldLessThanTheLots(t *testing.T) {
+	assert := assert.New(t)
+	lot, _ := NewTaxLot(10, 100, date(2025, 3))
+	stocks := newLottedAsset(0, lot)
+
+	assert.EqualError(stocks.SetQuantity(9), INVALID_TAX_LOT)
+	assert.EqualValues(10, stocks.Quantity)
+	assert.Nil(stocks.SetQuantity(12))
+	assert.EqualValues(12, stocks.Quantity)
+}
+
+func Test_Should_RejectInvalidTaxLots(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := NewTaxLot(0, 100, time.Now())
+	assert.EqualError(err, INVALID_TAX_LOT)
+	_, err = NewTaxLot(1, -1, time.Now())
+	assert.EqualError(err, INVALID_TAX_LOT)
+	_, err = NewTaxLot(1, 100, time.Time{})
+	assert.EqualError(err, INVALID_TAX_LOT)
+}
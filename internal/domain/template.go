@@ -0,0 +1,242 @@
+package domain
+
+import (
+	"math"
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+)
+
+type (
+	// PortfolioTemplate is a model allocation, assets and their scores
+	// without any values, that groups are made from. Groups made from it
+	// are kept linked and follow its scores.
+	PortfolioTemplate struct {
+		Id          uuid.UUID
+		Label       string
+		Description string
+		Tags        []string
+		// Owner is the principal that created the template.
+		Owner     string
+		Assets    []*TemplateAsset
+		CreatedAt time.Time
+		UpdatedAt time.Time
+
+		audit []*AuditEntry
+	}
+	TemplateAsset struct {
+		Id      uuid.UUID
+		Label   string
+		Ticker  string
+		Score   float32
+		Include bool
+	}
+)
+
+func NewTemplateAsset(label, ticker string, score float32, include bool) *TemplateAsset {
+	return &TemplateAsset{
+		Id:      uuid.New(),
+		Label:   label,
+		Ticker:  ticker,
+		Score:   score,
+		Include: include,
+	}
+}
+
+func NewPortfolioTemplate(
+	label, description string, tags []string, assets []*TemplateAsset, owner string, now time.Time) (*PortfolioTemplate, error) {
+	if err := checkTemplateScores(assets); err != nil {
+		return nil, err
+	}
+
+	pt := &PortfolioTemplate{
+		Id:          uuid.New(),
+		Label:       label,
+		Description: description,
+		Tags:        tags,
+		Owner:       owner,
+		Assets:      assets,
+		CreatedAt:   now,
+		UpdatedAt:   now,
+	}
+	pt.recordAudit(AUDIT_CREATE_TEMPLATE, DiffTemplates(nil, pt), now)
+
+	return pt, nil
+}
+
+// Update replaces the template with the one described. Assets keep
+// their link to the groups when they keep their id.
+func (pt *PortfolioTemplate) Update(
+	label, description string, tags []string, assets []*TemplateAsset, now time.Time) error {
+	if err := checkTemplateScores(assets); err != nil {
+		return err
+	}
+	before := *pt
+	pt.Label = label
+	pt.Description = description
+	pt.Tags = tags
+	pt.Assets = assets
+	pt.UpdatedAt = now
+	pt.recordAudit(AUDIT_UPDATE_TEMPLATE, DiffTemplates(&before, pt), now)
+
+	return nil
+}
+
+// Delete records that the template is deleted. The template itself is
+// deleted by the caller.
+func (pt *PortfolioTemplate) Delete(now time.Time) {
+	pt.recordAudit(AUDIT_DELETE_TEMPLATE, DiffTemplates(pt, nil), now)
+}
+
+// IsOwnedBy tells whether principal may change the template.
+func (pt *PortfolioTemplate) IsOwnedBy(principal string) bool {
+	return pt.Owner == principal
+}
+
+// recordAudit queues an audit entry to be stored along with the
+// template, like AssetsGroup.RecordAudit.
+func (pt *PortfolioTemplate) recordAudit(operation string, changes []*FieldChange, now time.Time) {
+	if len(changes) == 0 {
+		return
+	}
+	templateId := pt.Id
+	pt.audit = append(pt.audit, &AuditEntry{
+		Id:         uuid.New(),
+		TemplateId: &templateId,
+		Operation:  operation,
+		OccurredAt: now,
+		Changes:    changes,
+	})
+}
+
+// PullAudit hands over the recorded audit entries and clears them.
+func (pt *PortfolioTemplate) PullAudit() []*AuditEntry {
+	audit := pt.audit
+	pt.audit = nil
+	return audit
+}
+
+func (pt *PortfolioTemplate) FindAsset(id uuid.UUID) *TemplateAsset {
+	idx := slices.IndexFunc(pt.Assets, func(a *TemplateAsset) bool {
+		return a.Id == id
+	})
+	if idx < 0 {
+		return nil
+	}
+
+	return pt.Assets[idx]
+}
+
+// NewAssetsGroup makes a group linked to the template, with its assets
+// and no values yet.
+func (pt *PortfolioTemplate) NewAssetsGroup(label string, contributionTotal float64) *AssetsGroup {
+	assets := []*Asset{}
+	for _, ta := range pt.Assets {
+		assets = append(assets, pt.newAsset(ta, 0, contributionTotal))
+	}
+	group := NewAssetGroup(label, assets, contributionTotal)
+	templateId := pt.Id
+	group.TemplateId = &templateId
+
+	return group
+}
+
+func (pt *PortfolioTemplate) newAsset(ta *TemplateAsset, currentTotal, contributionTotal float64) *Asset {
+	a := NewAsset(ta.Label, ta.Score, 0, 0, currentTotal, contributionTotal, ta.Include)
+	a.Ticker = ta.Ticker
+	templateAssetId := ta.Id
+	a.TemplateAssetId = &templateAssetId
+
+	return a
+}
+
+// SyncWithTemplate gives the assets made from the template its current
+// scores and inclusion. Assets added to the template since are added with
+// no values, unless they were moved to the trash of the group. Those
+// removed from the template stay in the group, unlinked, excluded and
+// with a zero score. It tells whether the group changed.
+func (ag *AssetsGroup) SyncWithTemplate(pt *PortfolioTemplate) bool {
+	changed := false
+	linked := map[uuid.UUID]bool{}
+	for _, a := range ag.DeletedAssets {
+		if a.TemplateAssetId != nil {
+			linked[*a.TemplateAssetId] = true
+		}
+	}
+	for _, a := range ag.Assets {
+		if a.TemplateAssetId == nil {
+			continue
+		}
+		before := *a
+		if ta := pt.FindAsset(*a.TemplateAssetId); ta != nil {
+			linked[ta.Id] = true
+			a.Score = ta.Score
+			a.Include = ta.Include
+		} else {
+			a.Score = 0
+			a.Include = false
+			a.TemplateAssetId = nil
+		}
+		if a.Score != before.Score || a.Include != before.Include || a.TemplateAssetId == nil {
+			ag.RecordAssetChanges(AUDIT_SYNC_TEMPLATE, before, a)
+			changed = true
+		}
+	}
+	for _, ta := range pt.Assets {
+		if linked[ta.Id] {
+			continue
+		}
+		a := pt.newAsset(ta, ag.CurrentTotal(), ag.ContributionTotal)
+		ag.Assets = append(ag.Assets, a)
+		ag.Record(AssetAdded{
+			AssetId:      a.Id,
+			Label:        a.Label,
+			Score:        a.Score,
+			CurrentValue: a.CurrentValue,
+		})
+		ag.RecordAudit(AUDIT_SYNC_TEMPLATE, a.Id, DiffAssets(nil, a))
+		changed = true
+	}
+
+	return changed
+}
+
+// Duplicate copies the group, assets and values, under new ids for the
+// given owner. The copy keeps the template link but not the schedules,
+// the trash nor the tax lots, which are purchases of the previous owner.
+func (ag *AssetsGroup) Duplicate(label, owner string) *AssetsGroup {
+	dup := ag.Clone()
+	dup.Id = uuid.New()
+	dup.Label = label
+	dup.Owner = owner
+	dup.Version = 0
+	dup.ContributionSchedules = nil
+	dup.DeletedAt = nil
+	dup.DeletedAssets = nil
+	dup.events = nil
+	dup.audit = nil
+	for _, a := range dup.Assets {
+		a.Id = uuid.New()
+		a.TaxLots = nil
+		a.SaleEstimate = nil
+	}
+
+	return dup
+}
+
+// checkTemplateScores makes sure the scores of the included assets add
+// up to 100, give or take rounding.
+func checkTemplateScores(assets []*TemplateAsset) error {
+	sum := 0.
+	for _, a := range assets {
+		if a.Include {
+			sum += float64(a.Score)
+		}
+	}
+	if math.Abs(sum-100) > 0.01 {
+		return ErrInvalidScoreSum
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func newSixtyFortyTemplate() *PortfolioTemplate {
+	template, _ := NewPortfolioTemplate("60/40", "", nil, []*TemplateAsset{
+		NewTemplateAsset("Stocks", "VTI", 60, true),
+		NewTemplateAsset("Bonds", "BND", 40, true),
+	}, "alice", time.Now())
+	return template
+}
+
+func Test_Should_RequireTemplateScoresToAddUpTo100(t *testing.T) {
+	assert := assert.New(t)
+
+	_, err := NewPortfolioTemplate("uneven", "", nil, []*TemplateAsset{
+		NewTemplateAsset("Stocks", "", 60, true),
+		NewTemplateAsset("Bonds", "", 30, true),
+		NewTemplateAsset("Cash", "", 10, false),
+	}, "alice", time.Now())
+
+	assert.EqualError(err, INVALID_SCORE_SUM)
+}
+
+func Test_Should_MakeLinkedGroupsWithoutValues(t *testing.T) {
+	assert := assert.New(t)
+	template := newSixtyFortyTemplate()
+
+	group := template.NewAssetsGroup("mine", 1000)
+
+	assert.Equal(template.Id, *group.TemplateId)
+	if assert.Len(group.Assets, 2) {
+		assert.Equal(template.Assets[0].Id, *group.Assets[0].TemplateAssetId)
+		assert.Equal("VTI", group.Assets[0].Ticker)
+		assert.Zero(group.Assets[0].CurrentValue)
+		assert.EqualValues(600, group.Assets[0].FinalContribution)
+	}
+}
+
+func Test_Should_SyncGroupsWithTheirTemplate(t *testing.T) {
+	assert := assert.New(t)
+	template := newSixtyFortyTemplate()
+	group := template.NewAssetsGroup("mine", 1000)
+	stocks, bonds := group.Assets[0], group.Assets[1]
+	own := NewAsset("Own pick", 5, 0, 0, 0, 0, false)
+	group.Assets = append(group.Assets, own)
+	assert.False(group.SyncWithTemplate(template))
+
+	cash := NewTemplateAsset("Cash", "", 10, true)
+	template.Update("60/40", "", nil, []*TemplateAsset{
+		{Id: template.Assets[0].Id, Label: "Stocks", Score: 90, Include: true},
+		cash,
+	}, time.Now())
+
+	assert.True(group.SyncWithTemplate(template))
+	assert.EqualValues(90, stocks.Score)
+	assert.Zero(bonds.Score)
+	assert.False(bonds.Include)
+	assert.Nil(bonds.TemplateAssetId)
+	assert.EqualValues(5, own.Score)
+	if assert.Len(group.Assets, 4) {
+		assert.Equal(cash.Id, *group.Assets[3].TemplateAssetId)
+	}
+	assert.False(group.SyncWithTemplate(template))
+}
+
+func Test_Should_SyncTheInclusionOfGroupsWithTheirTemplate(t *testing.T) {
+	assert := assert.New(t)
+	template := newSixtyFortyTemplate()
+	group := template.NewAssetsGroup("mine", 1000)
+	group.PullAudit()
+
+	assert.Nil(template.Update("60/40", "", nil, []*TemplateAsset{
+		{Id: template.Assets[0].Id, Label: "Stocks", Score: 100, Include: true},
+		{Id: template.Assets[1].Id, Label: "Bonds", Score: 40, Include: false},
+	}, time.Now()))
+
+	assert.True(group.SyncWithTemplate(template))
+	assert.True(group.Assets[0].Include)
+	assert.False(group.Assets[1].Include)
+	assert.EqualValues(40, group.Assets[1].Score)
+	assert.Len(group.PullAudit(), 2)
+	assert.False(group.SyncWithTemplate(template))
+}
+
+func Test_Should_AuditTheChangesOfTemplates(t *testing.T) {
+	assert := assert.New(t)
+	template := newSixtyFortyTemplate()
+
+	assert.Nil(template.Update("70/30", "", nil, []*TemplateAsset{
+		{Id: template.Assets[0].Id, Label: "Stocks", Score: 70, Include: true},
+		{Id: template.Assets[1].Id, Label: "Bonds", Score: 30, Include: true},
+	}, time.Now()))
+	assert.Nil(template.Update("70/30", "", nil, template.Assets, time.Now()))
+	template.Delete(time.Now())
+
+	audit := template.PullAudit()
+	if assert.Len(audit, 3) {
+		assert.Equal(AUDIT_CREATE_TEMPLATE, audit[0].Operation)
+		assert.Equal(AUDIT_UPDATE_TEMPLATE, audit[1].Operation)
+		assert.Equal(AUDIT_DELETE_TEMPLATE, audit[2].Operation)
+		assert.Equal(template.Id, *audit[1].TemplateId)
+		assert.Equal(uuid.Nil, audit[1].AssetId)
+	}
+	assert.Empty(template.PullAudit())
+}
+
+func Test_Should_Not_SyncBackAssetsInTheTrash(t *testing.T) {
+	assert := assert.New(t)
+	template := newSixtyFortyTemplate()
+	group := template.NewAssetsGroup("mine", 1000)
+	group.TrashAssetAt(1, time.Now())
+
+	assert.False(group.SyncWithTemplate(template))
+	assert.Len(group.Assets, 1)
+}
+
+func Test_Should_DuplicateGroupsUnderNewIds(t *testing.T) {
+	assert := assert.New(t)
+	template := newSixtyFortyTemplate()
+	group := template.NewAssetsGroup("mine", 1000)
+	group.Owner = "alice"
+	group.Assets[0].CurrentValue = 500
+	group.Assets[0].Quantity = 5
+	lot, _ := NewTaxLot(5, 400, time.Now())
+	group.Assets[0].AddTaxLot(lot)
+	group.ContributionSchedules = []*ContributionSchedule{{Id: uuid.New()}}
+
+	dup := group.Duplicate("yours", "bob")
+
+	assert.NotEqual(group.Id, dup.Id)
+	assert.Equal("yours", dup.Label)
+	assert.Equal("bob", dup.Owner)
+	assert.Equal(group.TemplateId, dup.TemplateId)
+	assert.Nil(dup.ContributionSchedules)
+	if assert.Len(dup.Assets, 2) {
+		assert.NotEqual(group.Assets[0].Id, dup.Assets[0].Id)
+		assert.EqualValues(500, dup.Assets[0].CurrentValue)
+		assert.Empty(dup.Assets[0].TaxLots)
+		assert.Len(group.Assets[0].TaxLots, 1)
+		assert.Equal(group.Assets[0].TemplateAssetId, dup.Assets[0].TemplateAssetId)
+	}
+	dup.Assets[0].CurrentValue = 0
+	assert.EqualValues(500, group.Assets[0].CurrentValue)
+}
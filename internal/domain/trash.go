@@ -0,0 +1,100 @@
+package domain
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"golang.org/x/exp/slices"
+)
+
+func (ag *AssetsGroup) IsDeleted() bool {
+	return ag.DeletedAt != nil
+}
+
+// Trash moves the group to the trash, where it's kept until restored or
+// purged.
+func (ag *AssetsGroup) Trash(now time.Time) {
+	ag.Record(AssetsGroupDeleted{
+		Label: ag.Label,
+	})
+	ag.RecordAudit(AUDIT_DELETE_ASSETS_GROUP, uuid.Nil, DiffAssetsGroups(ag, nil))
+	ag.DeletedAt = &now
+}
+
+// Restore takes the group out of the trash.
+func (ag *AssetsGroup) Restore() {
+	before := *ag
+	ag.DeletedAt = nil
+	ag.Record(AssetsGroupRestored{
+		Label: ag.Label,
+	})
+	ag.RecordAudit(AUDIT_RESTORE_ASSETS_GROUP, uuid.Nil, DiffAssetsGroups(&before, ag))
+}
+
+// TrashAssetAt moves the asset at idx from the group to its trash.
+func (ag *AssetsGroup) TrashAssetAt(idx int, now time.Time) *Asset {
+	a := ag.Assets[idx]
+	ag.Record(AssetRemoved{
+		AssetId: a.Id,
+		Label:   a.Label,
+	})
+	ag.RecordAudit(AUDIT_DELETE_ASSET, a.Id, DiffAssets(a, nil))
+	ag.Assets = append(ag.Assets[:idx], ag.Assets[idx+1:]...)
+	a.DeletedAt = &now
+	ag.DeletedAssets = append(ag.DeletedAssets, a)
+
+	return a
+}
+
+// RestoreAsset moves the asset back from the trash into the group. It
+// returns nil when the asset isn't in the trash.
+func (ag *AssetsGroup) RestoreAsset(id uuid.UUID) *Asset {
+	idx := slices.IndexFunc(ag.DeletedAssets, func(a *Asset) bool {
+		return a.Id == id
+	})
+	if idx < 0 {
+		return nil
+	}
+
+	a := ag.DeletedAssets[idx]
+	ag.DeletedAssets = append(ag.DeletedAssets[:idx], ag.DeletedAssets[idx+1:]...)
+	a.DeletedAt = nil
+	ag.Assets = append(ag.Assets, a)
+	ag.Record(AssetRestored{
+		AssetId: a.Id,
+		Label:   a.Label,
+	})
+	ag.RecordAudit(AUDIT_RESTORE_ASSET, a.Id, DiffAssets(nil, a))
+
+	return a
+}
+
+// Purge records that the group is dropped from the trash for good. The
+// group itself is deleted by the caller.
+func (ag *AssetsGroup) Purge() {
+	ag.Record(AssetsGroupPurged{
+		Label: ag.Label,
+	})
+	ag.RecordAudit(AUDIT_PURGE_ASSETS_GROUP, uuid.Nil, DiffAssetsGroups(ag, nil))
+}
+
+// PurgeAssets drops the assets trashed before the given time for good,
+// returning how many were dropped.
+func (ag *AssetsGroup) PurgeAssets(before time.Time) int {
+	kept := []*Asset{}
+	for _, a := range ag.DeletedAssets {
+		if !a.DeletedAt.Before(before) {
+			kept = append(kept, a)
+			continue
+		}
+		ag.Record(AssetPurged{
+			AssetId: a.Id,
+			Label:   a.Label,
+		})
+		ag.RecordAudit(AUDIT_PURGE_ASSET, a.Id, DiffAssets(a, nil))
+	}
+	purged := len(ag.DeletedAssets) - len(kept)
+	ag.DeletedAssets = kept
+
+	return purged
+}
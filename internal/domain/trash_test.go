@@ -0,0 +1,58 @@
+package domain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Should_MoveAssetsToTheTrashAndBack(t *testing.T) {
+	assert := assert.New(t)
+	now := time.Now()
+	kept := NewAsset("kept", 50, 0, 50, 100, 0, true)
+	removed := NewAsset("removed", 50, 0, 50, 100, 0, true)
+	group := NewAssetGroup("test", []*Asset{kept, removed}, 100)
+
+	group.TrashAssetAt(1, now)
+
+	assert.Equal([]*Asset{kept}, group.Assets)
+	assert.Equal([]*Asset{removed}, group.DeletedAssets)
+	assert.Equal(&now, removed.DeletedAt)
+	assert.Nil(group.RestoreAsset(uuid.New()))
+
+	assert.Equal(removed, group.RestoreAsset(removed.Id))
+	assert.Equal([]*Asset{kept, removed}, group.Assets)
+	assert.Empty(group.DeletedAssets)
+	assert.Nil(removed.DeletedAt)
+
+	events, err := group.PullEvents()
+	assert.Nil(err)
+	if assert.Len(events, 2) {
+		assert.Equal(EVENT_ASSET_REMOVED, events[0].Type)
+		assert.Equal(EVENT_ASSET_RESTORED, events[1].Type)
+	}
+	audit := group.PullAudit()
+	if assert.Len(audit, 2) {
+		assert.Equal(AUDIT_DELETE_ASSET, audit[0].Operation)
+		assert.Equal(AUDIT_RESTORE_ASSET, audit[1].Operation)
+	}
+}
+
+func Test_Should_TrashAndRestoreTheGroup(t *testing.T) {
+	assert := assert.New(t)
+	group := NewAssetGroup("test", []*Asset{}, 100)
+
+	group.Trash(time.Now())
+	assert.True(group.IsDeleted())
+	group.Restore()
+	assert.False(group.IsDeleted())
+
+	audit := group.PullAudit()
+	if assert.Len(audit, 2) {
+		assert.Equal(AUDIT_DELETE_ASSETS_GROUP, audit[0].Operation)
+		assert.Equal(AUDIT_RESTORE_ASSETS_GROUP, audit[1].Operation)
+		assert.Equal("DeletedAt", audit[1].Changes[0].Field)
+	}
+}
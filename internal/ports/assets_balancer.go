@@ -15,8 +15,26 @@ type (
 		UpdateAssetsGroup(ctx context.Context, input *boundaries.UpdateAssetsGroup) (*domain.AssetsGroup, error)
 		DeleteAsset(ctx context.Context, input *boundaries.DeleteAssetInput) (*domain.AssetsGroup, error)
 		DeleteAssetsGroup(ctx context.Context, input *boundaries.DeleteAssetsGroupInput) error
+		RestoreAsset(ctx context.Context, input *boundaries.RestoreAssetInput) (*domain.AssetsGroup, error)
+		RestoreAssetsGroup(ctx context.Context, input *boundaries.RestoreAssetsGroupInput) (*domain.AssetsGroup, error)
+		ApplyBatch(ctx context.Context, input *boundaries.BatchAssetsInput) (*boundaries.BatchAssetsResult, error)
+		CloneAssetsGroup(ctx context.Context, input *boundaries.CloneAssetsGroupInput) (*domain.AssetsGroup, error)
+		SetGlidePath(ctx context.Context, input *boundaries.SetGlidePathInput) (*domain.AssetsGroup, error)
+		DeleteGlidePath(ctx context.Context, input *boundaries.DeleteGlidePathInput) (*domain.AssetsGroup, error)
+		SetAssetConstraints(ctx context.Context, input *boundaries.SetAssetConstraintsInput) (*domain.AssetsGroup, error)
+		DeleteAssetConstraints(ctx context.Context, input *boundaries.DeleteAssetConstraintsInput) (*domain.AssetsGroup, error)
+		SetTradingCosts(ctx context.Context, input *boundaries.SetTradingCostsInput) (*domain.AssetsGroup, error)
+		DeleteTradingCosts(ctx context.Context, input *boundaries.DeleteTradingCostsInput) (*domain.AssetsGroup, error)
+		AddTaxLot(ctx context.Context, input *boundaries.AddTaxLotInput) (*domain.AssetsGroup, error)
+		DeleteTaxLot(ctx context.Context, input *boundaries.DeleteTaxLotInput) (*domain.AssetsGroup, error)
+		PreviewGlidePath(ctx context.Context, input *boundaries.PreviewGlidePathInput) ([]*domain.GlidePathPreview, error)
 
 		GetAssetsGroups(ctx context.Context) []*domain.AssetsGroup
 		GetAssetsGroup(ctx context.Context, input *boundaries.GetAssetsGroupInput) *domain.AssetsGroup
+		GetDeletedAssetsGroups(ctx context.Context) []*domain.AssetsGroup
+		GetDeletedAssets(ctx context.Context, input *boundaries.GetAssetsGroupInput) ([]*domain.Asset, error)
+	}
+	TrashPurgeUseCase interface {
+		PurgeTrash(ctx context.Context) int
 	}
 )
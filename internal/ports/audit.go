@@ -0,0 +1,15 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+)
+
+type (
+	AuditUseCase interface {
+		GetAuditTrail(ctx context.Context, input *boundaries.GetAuditTrailInput) []*domain.AuditEntry
+		GetAuditTrails(ctx context.Context, input *boundaries.GetAuditTrailsInput) []*domain.AuditEntry
+	}
+)
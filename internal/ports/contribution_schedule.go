@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+)
+
+type (
+	ContributionScheduleUseCase interface {
+		CreateContributionSchedule(ctx context.Context, input *boundaries.CreateContributionScheduleInput) (*domain.AssetsGroup, error)
+		DeleteContributionSchedule(ctx context.Context, input *boundaries.DeleteContributionScheduleInput) (*domain.AssetsGroup, error)
+		GenerateDueRebalancePlans(ctx context.Context) []*domain.RebalancePlan
+		ApproveRebalancePlan(ctx context.Context, input *boundaries.ReviewRebalancePlanInput) (*domain.RebalancePlan, error)
+		RejectRebalancePlan(ctx context.Context, input *boundaries.ReviewRebalancePlanInput) (*domain.RebalancePlan, error)
+
+		GetRebalancePlans(ctx context.Context, input *boundaries.GetRebalancePlansInput) []*domain.RebalancePlan
+	}
+)
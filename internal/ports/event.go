@@ -0,0 +1,21 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+)
+
+type (
+	// Transactor runs fn in a transaction. Repository calls made with
+	// the context handed to fn take part in it.
+	Transactor interface {
+		WithinTransaction(ctx context.Context, fn func(ctx context.Context) error) error
+	}
+	EventSink interface {
+		Publish(ctx context.Context, e *domain.DomainEvent) error
+	}
+	EventDispatcherUseCase interface {
+		DispatchPendingEvents(ctx context.Context) int
+	}
+)
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+
+	"github.com/google/uuid"
+)
+
+type (
+	// GroupUpdateBroker carries every saved state of a group to the
+	// clients streaming it.
+	GroupUpdateBroker interface {
+		Publish(ctx context.Context, group *domain.AssetsGroup) error
+		// Subscribe returns the updates of the group until ctx is done,
+		// when the channel is closed.
+		Subscribe(ctx context.Context, groupId uuid.UUID) (<-chan *domain.AssetsGroup, error)
+	}
+)
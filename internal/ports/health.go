@@ -0,0 +1,12 @@
+package ports
+
+import "context"
+
+type (
+	// HealthCheck reports whether a dependency the service can't work
+	// without is reachable.
+	HealthCheck interface {
+		Name() string
+		Check(ctx context.Context) error
+	}
+)
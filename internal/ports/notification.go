@@ -0,0 +1,24 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+)
+
+type (
+	NotificationPublisher interface {
+		Publish(ctx context.Context, n *domain.Notification)
+	}
+	NotificationSender interface {
+		Send(ctx context.Context, s *domain.NotificationSubscription, n *domain.Notification) error
+	}
+	NotificationUseCase interface {
+		CreateSubscription(ctx context.Context, input *boundaries.CreateNotificationSubscriptionInput) (*domain.NotificationSubscription, error)
+		DeleteSubscription(ctx context.Context, input *boundaries.DeleteNotificationSubscriptionInput) error
+
+		GetSubscriptions(ctx context.Context, input *boundaries.GetNotificationSubscriptionsInput) []*domain.NotificationSubscription
+		GetDeliveries(ctx context.Context, input *boundaries.GetNotificationDeliveriesInput) []*domain.NotificationDelivery
+	}
+)
@@ -0,0 +1,20 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/romaopatrick/assets-balancer/internal/boundaries"
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+)
+
+type (
+	PortfolioTemplateUseCase interface {
+		CreateTemplate(ctx context.Context, input *boundaries.CreatePortfolioTemplateInput) (*domain.PortfolioTemplate, error)
+		UpdateTemplate(ctx context.Context, input *boundaries.UpdatePortfolioTemplateInput) (*domain.PortfolioTemplate, error)
+		DeleteTemplate(ctx context.Context, input *boundaries.DeletePortfolioTemplateInput) error
+		InstantiateTemplate(ctx context.Context, input *boundaries.InstantiatePortfolioTemplateInput) (*domain.AssetsGroup, error)
+
+		GetTemplates(ctx context.Context) []*domain.PortfolioTemplate
+		GetTemplate(ctx context.Context, input *boundaries.GetPortfolioTemplateInput) *domain.PortfolioTemplate
+	}
+)
@@ -0,0 +1,16 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/romaopatrick/assets-balancer/internal/domain"
+)
+
+type (
+	PriceProvider interface {
+		GetPrice(ctx context.Context, ticker string) (float64, error)
+	}
+	AssetsRevaluationUseCase interface {
+		RevaluateAssetsGroups(ctx context.Context) []*domain.AssetsGroup
+	}
+)
@@ -7,11 +7,20 @@ type Repository[T interface{}] interface {
 		filter map[string]interface{}) []T
 	GetFirst(ctx context.Context,
 		filter map[string]interface{}) T
+	Count(ctx context.Context,
+		filter map[string]interface{}) int64
+	// CountElements sums the lengths of an array field across the
+	// documents matching the filter.
+	CountElements(ctx context.Context,
+		filter map[string]interface{},
+		field string) int64
 	Insert(ctx context.Context,
 		entity T)
+	// Replace tells whether a document matched the filter.
 	Replace(ctx context.Context,
 		filter map[string]interface{},
-		entity T)
+		entity T) bool
+	// DeleteAll tells how many documents it deleted.
 	DeleteAll(ctx context.Context,
-		filter map[string]interface{})
+		filter map[string]interface{}) int64
 }